@@ -4,23 +4,36 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"runtime"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"github.com/OpenLiberty/open-liberty-operator/pkg/apis"
 	"github.com/OpenLiberty/open-liberty-operator/pkg/controller"
+	lutils "github.com/OpenLiberty/open-liberty-operator/pkg/utils"
 
+	autils "github.com/appsody/appsody-operator/pkg/utils"
 	"github.com/operator-framework/operator-sdk/pkg/k8sutil"
-	"github.com/operator-framework/operator-sdk/pkg/leader"
 	"github.com/operator-framework/operator-sdk/pkg/log/zap"
 	"github.com/operator-framework/operator-sdk/pkg/restmapper"
 	sdkVersion "github.com/operator-framework/operator-sdk/version"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 	"sigs.k8s.io/controller-runtime/pkg/runtime/signals"
 )
@@ -30,6 +43,15 @@ var (
 	metricsHost       = "0.0.0.0"
 	metricsPort int32 = 8383
 )
+
+// Change below variables to serve the health probes on different host or port.
+var (
+	healthProbeHost       = "0.0.0.0"
+	healthProbePort int32 = 8081
+)
+
+const leaderElectionID = "open-liberty-operator-lock"
+
 var log = logf.Log.WithName("cmd")
 
 func printVersion() {
@@ -47,6 +69,12 @@ func main() {
 	// controller-runtime)
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
 
+	leaderElect := pflag.Bool("leader-elect", true, "Elect a leader with a Lease so that only one replica of the operator is active")
+	leaderElectionNamespace := pflag.String("leader-election-namespace", "", "Namespace of the leader election Lease. Defaults to the namespace of the operator")
+	leaseDuration := pflag.Duration("leader-election-lease-duration", 15*time.Second, "Duration that standby replicas wait before taking over a Lease that is no longer renewed")
+	renewDeadline := pflag.Duration("leader-election-renew-deadline", 10*time.Second, "Duration that the leader retries renewing the Lease before giving up leadership")
+	retryPeriod := pflag.Duration("leader-election-retry-period", 2*time.Second, "Duration between attempts to acquire or renew the Lease")
+
 	pflag.Parse()
 
 	// Use a zap logr.Logger implementation. If none of the zap
@@ -61,15 +89,16 @@ func main() {
 
 	printVersion()
 
-	operatorNamespace, err := k8sutil.GetOperatorNamespace()
-	namespace, err := k8sutil.GetWatchNamespace()
-
+	// WATCH_NAMESPACE holds a comma-separated list of namespaces, or an empty value for all namespaces
+	watchNamespaces, err := autils.GetWatchNamespaces()
 	if err != nil {
 		log.Error(err, "Failed to get watch namespace")
 		os.Exit(1)
 	}
-	if namespace != operatorNamespace {
-		namespace = ""
+	selector, err := lutils.GetWatchNamespaceSelector()
+	if err != nil {
+		log.Error(err, "Failed to parse "+lutils.WatchNamespaceSelectorEnvVar)
+		os.Exit(1)
 	}
 
 	// Get a config to talk to the apiserver
@@ -78,46 +107,223 @@ func main() {
 		log.Error(err, "")
 		os.Exit(1)
 	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		log.Error(err, "")
+		os.Exit(1)
+	}
+
+	// Metrics are served outside of the manager, which is restarted when the watched namespaces change
+	go serveMetrics(fmt.Sprintf("%s:%d", metricsHost, metricsPort))
+
+	stop := signals.SetupSignalHandler()
+	runManagers := func(stop <-chan struct{}) {
+		for {
+			restart, err := run(cfg, clientset, watchNamespaces, selector, stop)
+			if err != nil {
+				log.Error(err, "Manager exited non-zero")
+				os.Exit(1)
+			}
+			if !restart {
+				return
+			}
+			log.Info("Restarting the Cmd to watch the new namespaces.")
+		}
+	}
 
-	ctx := context.TODO()
+	if *leaderElect && *leaderElectionNamespace == "" {
+		*leaderElectionNamespace, err = k8sutil.GetOperatorNamespace()
+		if err == k8sutil.ErrRunLocal || err == k8sutil.ErrNoNamespace {
+			// Running outside of a cluster, e.g. with operator-sdk up local, there is no other replica to elect
+			log.Info("Skipping the leader election; not running in a cluster.")
+			*leaderElect = false
+		} else if err != nil {
+			log.Error(err, "Failed to get the namespace of the leader election Lease, set --leader-election-namespace")
+			os.Exit(1)
+		}
+	}
 
-	// Become the leader before proceeding
-	err = leader.Become(ctx, "open-liberty-operator-lock")
+	if !*leaderElect {
+		lutils.SetLeader(true)
+		go serveHealthProbes(fmt.Sprintf("%s:%d", healthProbeHost, healthProbePort), nil)
+		runManagers(stop)
+		return
+	}
+
+	identity, err := getLeaderElectionIdentity()
 	if err != nil {
 		log.Error(err, "")
 		os.Exit(1)
 	}
 
-	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := manager.New(cfg, manager.Options{
-		Namespace:          namespace,
-		MapperProvider:     restmapper.NewDynamicRESTMapper,
-		MetricsBindAddress: fmt.Sprintf("%s:%d", metricsHost, metricsPort),
+	// The watchdog fails the liveness probe when the leader stops renewing the Lease
+	watchdog := leaderelection.NewLeaderHealthzAdaptor(*renewDeadline)
+	go serveHealthProbes(fmt.Sprintf("%s:%d", healthProbeHost, healthProbePort), watchdog)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	log.Info("Electing the leader", "lease", *leaderElectionNamespace+"/"+leaderElectionID, "identity", identity)
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      leaderElectionID,
+				Namespace: *leaderElectionNamespace,
+			},
+			Client:     clientset.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		},
+		LeaseDuration: *leaseDuration,
+		RenewDeadline: *renewDeadline,
+		RetryPeriod:   *retryPeriod,
+		// Release the Lease on shutdown so that a standby replica takes over without waiting for it to expire
+		ReleaseOnCancel: true,
+		WatchDog:        watchdog,
+		Name:            leaderElectionID,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				log.Info("Became the leader", "identity", identity)
+				lutils.SetLeader(true)
+				runManagers(ctx.Done())
+				cancel()
+			},
+			OnStoppedLeading: func() {
+				lutils.SetLeader(false)
+				select {
+				case <-stop:
+					log.Info("Stopped the leader election", "identity", identity)
+				default:
+					// The controllers can't be stopped reliably once the Lease is lost, so restart the operator
+					log.Info("Lost the leadership", "identity", identity)
+					os.Exit(1)
+				}
+			},
+			OnNewLeader: func(current string) {
+				if current != identity {
+					log.Info("Another replica is the leader", "leader", current)
+				}
+			},
+		},
 	})
-	if err != nil {
-		log.Error(err, "")
+}
+
+// getLeaderElectionIdentity returns a unique identity of this replica, based on the name of its pod
+func getLeaderElectionIdentity() (string, error) {
+	id := os.Getenv(k8sutil.PodNameEnvVar)
+	if id == "" {
+		var err error
+		if id, err = os.Hostname(); err != nil {
+			return "", err
+		}
+	}
+	return id + "_" + string(uuid.NewUUID()), nil
+}
+
+// serveHealthProbes serves the liveness probe on /healthz and the readiness probe on /readyz. Standby replicas are
+// ready too, so that rolling updates of the operator can complete; the leadership is reported in the leader metric.
+func serveHealthProbes(address string, watchdog *leaderelection.HealthzAdaptor) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
+		if watchdog != nil {
+			if err := watchdog.Check(req); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+		fmt.Fprint(w, "ok")
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, req *http.Request) {
+		fmt.Fprint(w, "ok")
+	})
+	log.Info("Serving health probes", "address", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Error(err, "Failed to serve health probes")
 		os.Exit(1)
 	}
+}
+
+// run starts a manager watching the given namespaces and the namespaces matching the selector. It returns true when
+// the manager was stopped because the namespaces matching the selector changed. Each manager creates new workqueues,
+// so the workqueue metrics of the previous manager are unregistered first and the new ones start again from zero.
+func run(cfg *rest.Config, clientset kubernetes.Interface, watchNamespaces []string, selector labels.Selector, stop <-chan struct{}) (bool, error) {
+	namespaces, err := lutils.ResolveWatchNamespaces(clientset, watchNamespaces, selector)
+	if err != nil {
+		return false, fmt.Errorf("Failed to resolve the namespaces to watch: %v", err)
+	}
+	lutils.SetWatchNamespaces(namespaces)
+	log.Info("Resolved the namespaces to watch", "namespaces", namespaces)
+
+	// Only the watched namespaces are cached, so that the operator doesn't need cluster-wide access
+	options := manager.Options{
+		MapperProvider:     restmapper.NewDynamicRESTMapper,
+		MetricsBindAddress: "0",
+	}
+	if len(namespaces) == 1 {
+		options.Namespace = namespaces[0]
+	} else {
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	}
+
+	// Create a new Cmd to provide shared dependencies and start components
+	mgr, err := manager.New(cfg, options)
+	if err != nil {
+		return false, err
+	}
 
 	log.Info("Registering Components.")
+	lutils.UnregisterWorkqueueMetrics()
 
 	// Setup Scheme for all resources
 	if err := apis.AddToScheme(mgr.GetScheme()); err != nil {
-		log.Error(err, "")
-		os.Exit(1)
+		return false, err
 	}
 
 	// Setup all Controllers
 	if err := controller.AddToManager(mgr); err != nil {
-		log.Error(err, "")
-		os.Exit(1)
+		return false, err
 	}
-	
+
+	mgrStop := make(chan struct{})
+	restart := make(chan struct{})
+	if selector != nil && !lutils.IsClusterWide(namespaces) {
+		lutils.WatchNamespaceChanges(clientset, watchNamespaces, selector, namespaces, mgrStop, func(changed []string) {
+			log.Info("Namespaces matching "+lutils.WatchNamespaceSelectorEnvVar+" changed", "namespaces", changed)
+			close(restart)
+		})
+	}
+	go func() {
+		select {
+		case <-stop:
+		case <-restart:
+		}
+		close(mgrStop)
+	}()
+
 	log.Info("Starting the Cmd.")
 
 	// Start the Cmd
-	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
-		log.Error(err, "Manager exited non-zero")
+	if err := mgr.Start(mgrStop); err != nil {
+		return false, err
+	}
+	select {
+	case <-restart:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func serveMetrics(address string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{
+		ErrorHandling: promhttp.HTTPErrorOnError,
+	}))
+	log.Info("Serving metrics", "address", address)
+	if err := http.ListenAndServe(address, mux); err != nil {
+		log.Error(err, "Failed to serve metrics")
 		os.Exit(1)
 	}
 }
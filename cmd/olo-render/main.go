@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/OpenLiberty/open-liberty-operator/pkg/render"
+	"github.com/spf13/pflag"
+)
+
+func main() {
+	filename := pflag.StringP("filename", "f", "-", "File holding the OpenLibertyApplications and the resources they refer to, or - for the standard input")
+	options := render.Options{}
+	pflag.StringVarP(&options.Namespace, "namespace", "n", "default", "Namespace of the resources that don't set one")
+	pflag.BoolVar(&options.Route, "route", false, "Assume that OpenShift Routes are available")
+	pflag.BoolVar(&options.Knative, "knative", false, "Assume that Knative Serving is available")
+	pflag.BoolVar(&options.Prometheus, "prometheus", false, "Assume that the Prometheus Operator is available")
+	pflag.BoolVar(&options.ResolveImageDigest, "resolve-image-digest", false, "Contact the registry to resolve the digest of the application images that set imageDigest")
+	pflag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Prints the resources that the Open Liberty Operator creates for OpenLibertyApplications, without a cluster.\n\n")
+		fmt.Fprintf(os.Stderr, "Usage:\n  olo-render -f app.yaml [flags]\n\nFlags:\n")
+		pflag.PrintDefaults()
+	}
+	pflag.Parse()
+
+	if err := run(*filename, options, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(filename string, options render.Options, out io.Writer) error {
+	in := os.Stdin
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	s, err := render.NewScheme()
+	if err != nil {
+		return err
+	}
+	objs, err := render.Decode(s, in)
+	if err != nil {
+		return fmt.Errorf("Failed to read %s: %v", filename, err)
+	}
+	rendered, err := render.Render(s, objs, options)
+	if err != nil {
+		return err
+	}
+	data, err := render.Marshal(rendered)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
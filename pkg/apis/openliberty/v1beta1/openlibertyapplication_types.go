@@ -5,6 +5,7 @@ import (
 	prometheusv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -28,6 +29,8 @@ type OpenLibertyApplicationSpec struct {
 	ResourceConstraints *corev1.ResourceRequirements  `json:"resourceConstraints,omitempty"`
 	ReadinessProbe      *corev1.Probe                 `json:"readinessProbe,omitempty"`
 	LivenessProbe       *corev1.Probe                 `json:"livenessProbe,omitempty"`
+	StartupProbe        *corev1.Probe                 `json:"startupProbe,omitempty"`
+	Probes              *OpenLibertyApplicationProbes `json:"probes,omitempty"`
 	Service             OpenLibertyApplicationService `json:"service,omitempty"`
 	Expose              *bool                         `json:"expose,omitempty"`
 	// +listType=atomic
@@ -44,8 +47,153 @@ type OpenLibertyApplicationSpec struct {
 	CreateAppDefinition  *bool                             `json:"createAppDefinition,omitempty"`
 	// +listType=map
 	// +listMapKey=name
-	InitContainers []corev1.Container                    `json:"initContainers,omitempty"`
-	Serviceability *OpenLibertyApplicationServiceability `json:"serviceability,omitempty"`
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	SidecarContainers []corev1.Container                    `json:"sidecarContainers,omitempty"`
+	Serviceability    *OpenLibertyApplicationServiceability `json:"serviceability,omitempty"`
+	SessionCache      *OpenLibertyApplicationSessionCache   `json:"sessionCache,omitempty"`
+	SSO               *OpenLibertyApplicationSSO            `json:"sso,omitempty"`
+	LTPA              *OpenLibertyApplicationLTPA           `json:"ltpa,omitempty"`
+	ImageDigest       *OpenLibertyApplicationImageDigest    `json:"imageDigest,omitempty"`
+	Shutdown          *OpenLibertyApplicationShutdown       `json:"shutdown,omitempty"`
+	// Application files downloaded into the server configuration when the pods start
+	// +listType=map
+	// +listMapKey=name
+	Artifacts  []OpenLibertyApplicationArtifact  `json:"artifacts,omitempty"`
+	LogWatcher *OpenLibertyApplicationLogWatcher `json:"logWatcher,omitempty"`
+	// Rules remediating the pods that log messages, which require the log watcher
+	Remediation *OpenLibertyApplicationRemediation `json:"remediation,omitempty"`
+	Logging     *OpenLibertyApplicationLogging     `json:"logging,omitempty"`
+	// Adds a readiness gate to the pods, so that the pods paused by an OpenLibertyPause are not ready.
+	// New pods only become ready once the operator reported that they are not paused.
+	PauseReadinessGate *bool `json:"pauseReadinessGate,omitempty"`
+	// Stops the changes to the resources of the application, which are reported as drift in the Paused condition
+	Paused *bool `json:"paused,omitempty"`
+}
+
+// OpenLibertyApplicationLogWatcher follows the logs of the pods to report the errors of the server
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationLogWatcher struct {
+	// Set to true to follow the logs of the pods and report the errors in the ApplicationErrors condition
+	Enabled *bool `json:"enabled,omitempty"`
+	// IDs of messages reported as errors in addition to the default ones, e.g. CWWKE0701E
+	// +listType=set
+	MessageIDs []string                              `json:"messageIDs,omitempty"`
+	FFDC       *OpenLibertyApplicationLogWatcherFFDC `json:"ffdc,omitempty"`
+	// Set to true to report the applications and the features started by the servers of the pods, and how long
+	// the servers took to start, in the status
+	Inventory *bool `json:"inventory,omitempty"`
+}
+
+// OpenLibertyApplicationLogging configures the logs of the server
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationLogging struct {
+	// Level of the messages written to the console. Defaults to info.
+	// +kubebuilder:validation:Enum=info;audit;warning;error;off
+	ConsoleLogLevel string `json:"consoleLogLevel,omitempty"`
+	// Sources written to the console. Defaults to message, accessLog, ffdc and audit.
+	// +listType=set
+	ConsoleSources []OpenLibertyLoggingSource `json:"consoleSources,omitempty"`
+	// Format of the console. Defaults to json.
+	// +kubebuilder:validation:Enum=json;dev;simple
+	ConsoleFormat string `json:"consoleFormat,omitempty"`
+	// Maximum size of the message and trace log files in MB, 0 for no limit
+	// +kubebuilder:validation:Minimum=0
+	MaxFileSize *int32 `json:"maxFileSize,omitempty"`
+	// Maximum number of message and trace log files kept, 0 for no limit
+	// +kubebuilder:validation:Minimum=0
+	MaxFiles *int32 `json:"maxFiles,omitempty"`
+	// New names of the fields of the JSON logs, e.g. message: msg. Fields of a source are prefixed with the
+	// source, e.g. accessLog:ibm_requestHost: host.
+	JSONFieldMappings map[string]string `json:"jsonFieldMappings,omitempty"`
+	// Fields of the JSON access logs, default or logFormat to use the fields of the access log format
+	// +kubebuilder:validation:Enum=default;logFormat
+	JSONAccessLogFields string `json:"jsonAccessLogFields,omitempty"`
+	// Format of the access logs of the default HTTP endpoint, e.g. %h %u %t "%r" %s %b. Replaces the accessLoggingRef
+	// of the defaultHttpEndpoint, so the access logging configured for it in the server configuration is not used.
+	AccessLogFormat  string                                  `json:"accessLogFormat,omitempty"`
+	RuntimeOverrides *OpenLibertyApplicationLoggingOverrides `json:"runtimeOverrides,omitempty"`
+}
+
+// OpenLibertyApplicationLoggingOverrides defines logging settings applied to the running servers through a
+// configDropin, without restarting the pods
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationLoggingOverrides struct {
+	// Level of the messages written to the console
+	// +kubebuilder:validation:Enum=info;audit;warning;error;off
+	ConsoleLogLevel string `json:"consoleLogLevel,omitempty"`
+	// Sources written to the console
+	// +listType=set
+	ConsoleSources []OpenLibertyLoggingSource `json:"consoleSources,omitempty"`
+	// Sources written to the messages.log file
+	// +listType=set
+	MessageSources []OpenLibertyLoggingSource `json:"messageSources,omitempty"`
+	// Format of the messages.log file
+	// +kubebuilder:validation:Enum=simple;json
+	MessageFormat string `json:"messageFormat,omitempty"`
+}
+
+// OpenLibertyLoggingSource is a source of the logs written to the console
+// +kubebuilder:validation:Enum=message;trace;accessLog;ffdc;audit
+type OpenLibertyLoggingSource string
+
+// OpenLibertyApplicationRemediation defines the rules remediating the pods and the limits that prevent the
+// remediations from cascading
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationRemediation struct {
+	// +listType=map
+	// +listMapKey=name
+	Rules []OpenLibertyApplicationRemediationRule `json:"rules,omitempty"`
+	// Maximum number of pods remediated at the same time. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrent *int32 `json:"maxConcurrent,omitempty"`
+	// Minimum time between the start of two remediations of the application, e.g. 10m. Defaults to 10m.
+	MinInterval string `json:"minInterval,omitempty"`
+}
+
+// OpenLibertyApplicationRemediationRule remediates a pod that logged a message a number of times within a period
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationRemediationRule struct {
+	Name string `json:"name"`
+	// ID of the message counted by the rule, e.g. TRAS0112W
+	MessageID string `json:"messageID"`
+	// Number of messages logged by a pod within the period that triggers the rule. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	Threshold *int32 `json:"threshold,omitempty"`
+	// Period the messages are counted in, e.g. 5m. Defaults to 5m.
+	Period string `json:"period,omitempty"`
+	// Dump of the server taken through an OpenLibertyDump before the action. Requires the serviceability storage.
+	Dump *OpenLibertyApplicationRemediationDump `json:"dump,omitempty"`
+	// Action run on the pod, once the dump completed. Defaults to Delete.
+	Action OpenLibertyApplicationRemediationAction `json:"action,omitempty"`
+}
+
+// OpenLibertyApplicationRemediationDump defines the dump taken by a remediation
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationRemediationDump struct {
+	// +listType=set
+	Include []OpenLibertyDumpInclude `json:"include,omitempty"`
+}
+
+// OpenLibertyApplicationRemediationAction is the action run on a remediated pod
+// +kubebuilder:validation:Enum=Delete;None
+type OpenLibertyApplicationRemediationAction string
+
+const (
+	// RemediationActionDelete deletes the pod, which is recreated by its workload
+	RemediationActionDelete OpenLibertyApplicationRemediationAction = "Delete"
+	// RemediationActionNone only takes the dump
+	RemediationActionNone OpenLibertyApplicationRemediationAction = "None"
+)
+
+// OpenLibertyApplicationLogWatcherFFDC aggregates the FFDC incidents of the pods
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationLogWatcherFFDC struct {
+	// Set to true to aggregate the FFDC incidents of the pods in an OpenLibertyIncidents named after the application
+	Enabled *bool `json:"enabled,omitempty"`
+	// Set to true to copy the incident files to the serviceability volume
+	CopyToServiceability *bool `json:"copyToServiceability,omitempty"`
 }
 
 // OpenLibertyApplicationAutoScaling ...
@@ -98,6 +246,193 @@ type OpenLibertyApplicationServiceability struct {
 	VolumeClaimName string `json:"volumeClaimName,omitempty"`
 }
 
+// OpenLibertyApplicationProbes ...
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationProbes struct {
+	Defaults OpenLibertyApplicationProbesDefaults `json:"defaults,omitempty"`
+}
+
+// OpenLibertyApplicationProbesDefaults defines the possible modes for generating default probes
+// +kubebuilder:validation:Enum=mpHealth
+type OpenLibertyApplicationProbesDefaults string
+
+const (
+	// OpenLibertyApplicationProbesDefaultsMPHealth generates probes against the MicroProfile Health endpoints
+	OpenLibertyApplicationProbesDefaultsMPHealth OpenLibertyApplicationProbesDefaults = "mpHealth"
+)
+
+// OpenLibertyApplicationSessionCache ...
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationSessionCache struct {
+	Provider     OpenLibertyApplicationSessionCacheProvider `json:"provider,omitempty"`
+	Mode         OpenLibertyApplicationSessionCacheMode     `json:"mode,omitempty"`
+	ClusterName  string                                     `json:"clusterName,omitempty"`
+	LibraryImage string                                     `json:"libraryImage,omitempty"`
+	LibraryPath  string                                     `json:"libraryPath,omitempty"`
+	// +listType=set
+	ServerAddresses []string `json:"serverAddresses,omitempty"`
+	// +kubebuilder:validation:Pattern=.+
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+}
+
+// OpenLibertyApplicationSessionCacheProvider defines the possible JCache providers for session caching
+// +kubebuilder:validation:Enum=hazelcast;infinispan
+type OpenLibertyApplicationSessionCacheProvider string
+
+const (
+	// OpenLibertyApplicationSessionCacheProviderHazelcast Hazelcast
+	OpenLibertyApplicationSessionCacheProviderHazelcast OpenLibertyApplicationSessionCacheProvider = "hazelcast"
+	// OpenLibertyApplicationSessionCacheProviderInfinispan Infinispan
+	OpenLibertyApplicationSessionCacheProviderInfinispan OpenLibertyApplicationSessionCacheProvider = "infinispan"
+)
+
+// OpenLibertyApplicationSessionCacheMode defines how pods join the session cache cluster
+// +kubebuilder:validation:Enum=embedded;client
+type OpenLibertyApplicationSessionCacheMode string
+
+const (
+	// OpenLibertyApplicationSessionCacheModeEmbedded each pod is a member of the cache cluster
+	OpenLibertyApplicationSessionCacheModeEmbedded OpenLibertyApplicationSessionCacheMode = "embedded"
+	// OpenLibertyApplicationSessionCacheModeClient pods connect to an existing cache cluster
+	OpenLibertyApplicationSessionCacheModeClient OpenLibertyApplicationSessionCacheMode = "client"
+)
+
+// OpenLibertyApplicationSSO ...
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationSSO struct {
+	// +listType=map
+	// +listMapKey=id
+	OIDC                    []OpenLibertyApplicationSSOOIDC     `json:"oidc,omitempty"`
+	GitHub                  *OpenLibertyApplicationSSOProvider  `json:"github,omitempty"`
+	Google                  *OpenLibertyApplicationSSOProvider  `json:"google,omitempty"`
+	OpenShift               *OpenLibertyApplicationSSOOpenShift `json:"openShift,omitempty"`
+	RedirectToRPHostAndPort string                              `json:"redirectToRPHostAndPort,omitempty"`
+	MapToUserRegistry       *bool                               `json:"mapToUserRegistry,omitempty"`
+}
+
+// OpenLibertyApplicationSSOProvider represents the client registered with a login provider
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationSSOProvider struct {
+	// The secret that contains the client ID
+	ClientID corev1.SecretKeySelector `json:"clientId"`
+	// The secret that contains the client secret
+	ClientSecret corev1.SecretKeySelector `json:"clientSecret"`
+}
+
+// OpenLibertyApplicationSSOOIDC represents a generic OpenID Connect provider
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationSSOOIDC struct {
+	OpenLibertyApplicationSSOProvider `json:",inline"`
+
+	// +kubebuilder:validation:Pattern=^[a-zA-Z0-9_-]+$
+	ID string `json:"id"`
+	// +kubebuilder:validation:Pattern=^https://
+	DiscoveryEndpoint string `json:"discoveryEndpoint"`
+	Scope             string `json:"scope,omitempty"`
+	UserNameAttribute string `json:"userNameAttribute,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+}
+
+// OpenLibertyApplicationSSOOpenShift represents the OpenShift OAuth server. The service account
+// of the application is used as the OAuth client unless a client is specified.
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationSSOOpenShift struct {
+	// The secret that contains the client ID
+	ClientID *corev1.SecretKeySelector `json:"clientId,omitempty"`
+	// The secret that contains the client secret
+	ClientSecret *corev1.SecretKeySelector `json:"clientSecret,omitempty"`
+}
+
+// OpenLibertyApplicationLTPA ...
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationLTPA struct {
+	Scope OpenLibertyApplicationLTPAScope `json:"scope,omitempty"`
+	// Interval between key rotations, e.g. 720h. Keys are not rotated when not set.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	RotationInterval string `json:"rotationInterval,omitempty"`
+}
+
+// OpenLibertyApplicationLTPAScope defines which applications share the LTPA keys
+// +kubebuilder:validation:Enum=application;namespace
+type OpenLibertyApplicationLTPAScope string
+
+const (
+	// OpenLibertyApplicationLTPAScopeApplication the pods of the application share the keys
+	OpenLibertyApplicationLTPAScopeApplication OpenLibertyApplicationLTPAScope = "application"
+	// OpenLibertyApplicationLTPAScopeNamespace all applications of the namespace share the keys
+	OpenLibertyApplicationLTPAScopeNamespace OpenLibertyApplicationLTPAScope = "namespace"
+)
+
+// OpenLibertyApplicationImageDigest ...
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationImageDigest struct {
+	// Interval between checks of the registry for a new digest of the image tag, e.g. 10m.
+	// The tag is resolved again only when the application image changes when not set.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	PollInterval string `json:"pollInterval,omitempty"`
+}
+
+// OpenLibertyApplicationShutdown configures how the pods stop, so that the requests in progress complete
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationShutdown struct {
+	// Delay before the server stops, so that the pod is removed from the endpoints of the Services and routers
+	// first, e.g. 10s. Defaults to 10s when the application is exposed and to 5s otherwise. Set to 0s to disable.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	DrainDelay string `json:"drainDelay,omitempty"`
+	// Time allowed for the server to stop once the drain delay elapsed, e.g. 30s. Defaults to 30s, the time Liberty
+	// waits for the requests in progress to complete. Set to 0s to let the container stop without running server stop.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	StopTimeout string `json:"stopTimeout,omitempty"`
+	// Time after which the pod is killed once it starts terminating.
+	// Defaults to the sum of the drain delay and stop timeout, plus 5 seconds.
+	// +kubebuilder:validation:Minimum=0
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+}
+
+// OpenLibertyApplicationArtifact is a file downloaded from a URL or a Maven repository
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationArtifact struct {
+	// Name of the file the artifact is saved as, e.g. app.war
+	// +kubebuilder:validation:Pattern=^[^/]+$
+	Name string `json:"name"`
+	// URL to download the artifact from. Either url or maven must be set.
+	URL   string                               `json:"url,omitempty"`
+	Maven *OpenLibertyApplicationMavenArtifact `json:"maven,omitempty"`
+	// Expected SHA-256 checksum of the artifact. The checksum published with Maven artifacts is used when it is not set.
+	// +kubebuilder:validation:Pattern=^[a-fA-F0-9]{64}$
+	SHA256 string `json:"sha256,omitempty"`
+	// Name of the Secret holding the username and password used to download the artifact
+	CredentialsSecret string `json:"credentialsSecret,omitempty"`
+	// Directory of the server configuration the artifact is saved in. Applications in the apps directory must be
+	// configured in server.xml. Defaults to dropins.
+	// +kubebuilder:validation:Enum=dropins;apps
+	Directory OpenLibertyApplicationArtifactDirectory `json:"directory,omitempty"`
+}
+
+// OpenLibertyApplicationMavenArtifact identifies an artifact of a Maven repository
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationMavenArtifact struct {
+	// URL of the repository. Defaults to Maven Central.
+	Repository string `json:"repository,omitempty"`
+	GroupID    string `json:"groupId"`
+	ArtifactID string `json:"artifactId"`
+	// Release version of the artifact. SNAPSHOT versions are not resolved.
+	Version string `json:"version"`
+	// Extension of the artifact file. Defaults to war.
+	Type       string `json:"type,omitempty"`
+	Classifier string `json:"classifier,omitempty"`
+}
+
+// OpenLibertyApplicationArtifactDirectory is a directory of the server configuration holding applications
+type OpenLibertyApplicationArtifactDirectory string
+
+const (
+	// OpenLibertyApplicationArtifactDirectoryDropins is monitored by the server, which starts the applications it holds
+	OpenLibertyApplicationArtifactDirectoryDropins OpenLibertyApplicationArtifactDirectory = "dropins"
+	// OpenLibertyApplicationArtifactDirectoryApps holds the applications configured in server.xml
+	OpenLibertyApplicationArtifactDirectoryApps OpenLibertyApplicationArtifactDirectory = "apps"
+)
+
 // OpenLibertyApplicationStatus defines the observed state of OpenLibertyApplication
 // +k8s:openapi-gen=true
 type OpenLibertyApplicationStatus struct {
@@ -105,6 +440,162 @@ type OpenLibertyApplicationStatus struct {
 	// +listMapKey=type
 	Conditions       []StatusCondition       `json:"conditions,omitempty"`
 	ConsumedServices common.ConsumedServices `json:"consumedServices,omitempty"`
+	// The application image that was resolved to ResolvedImage
+	ImageReference string `json:"imageReference,omitempty"`
+	// The application image pinned to its digest
+	ResolvedImage     string       `json:"resolvedImage,omitempty"`
+	ImageLastResolved *metav1.Time `json:"imageLastResolved,omitempty"`
+	// The values taken from OpenLibertyDefaults and OpenLibertyClusterDefaults
+	AppliedDefaults *OpenLibertyDefaultsSpec `json:"appliedDefaults,omitempty"`
+	// +listType=set
+	DefaultsSources []string `json:"defaultsSources,omitempty"`
+	// The generation of the application that was last reconciled
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Number of replicas requested and ready
+	Replicas      int32 `json:"replicas,omitempty"`
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	Endpoints []OpenLibertyApplicationEndpoint `json:"endpoints,omitempty"`
+	// +listType=atomic
+	References []OpenLibertyApplicationResourceReference `json:"references,omitempty"`
+	// Errors logged by the servers of the pods, when the log watcher is enabled
+	// +listType=map
+	// +listMapKey=podName
+	ApplicationErrors []OpenLibertyApplicationPodErrors `json:"applicationErrors,omitempty"`
+	// Applications and features started by the servers of the pods, when the inventory of the log watcher is enabled
+	// +listType=map
+	// +listMapKey=podName
+	Inventory []OpenLibertyApplicationPodInventory `json:"inventory,omitempty"`
+	// The last remediations of the pods, from the oldest
+	// +listType=atomic
+	Remediations []OpenLibertyApplicationRemediationRecord `json:"remediations,omitempty"`
+	// Runtime logging overrides applied to the servers of the pods
+	// +listType=map
+	// +listMapKey=podName
+	RuntimeLogging []OpenLibertyApplicationPodRuntimeLogging `json:"runtimeLogging,omitempty"`
+}
+
+// OpenLibertyApplicationPodErrors reports the errors logged by the server of a pod
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationPodErrors struct {
+	PodName string `json:"podName"`
+	// +listType=map
+	// +listMapKey=messageID
+	Messages []OpenLibertyApplicationErrorMessage `json:"messages"`
+}
+
+// OpenLibertyApplicationErrorMessage counts the messages logged with an ID
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationErrorMessage struct {
+	MessageID string `json:"messageID"`
+	Count     int32  `json:"count"`
+	// The last message logged with the ID
+	Message string `json:"message,omitempty"`
+}
+
+// OpenLibertyApplicationPodInventory reports the applications and the features started by the server of a pod
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationPodInventory struct {
+	PodName string `json:"podName"`
+	// +listType=set
+	Applications []string `json:"applications,omitempty"`
+	// +listType=set
+	ContextRoots []string `json:"contextRoots,omitempty"`
+	// +listType=set
+	Features []string `json:"features,omitempty"`
+	// Time the server took to start, e.g. 5.123s
+	StartDuration string `json:"startDuration,omitempty"`
+}
+
+// OpenLibertyApplicationRemediationRecord reports a remediation of a pod
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationRemediationRecord struct {
+	Rule    string `json:"rule"`
+	PodName string `json:"podName"`
+	// Number of messages logged within the period of the rule
+	Count          int32                                   `json:"count"`
+	Action         OpenLibertyApplicationRemediationAction `json:"action"`
+	DumpName       string                                  `json:"dumpName,omitempty"`
+	Phase          OpenLibertyApplicationRemediationPhase  `json:"phase"`
+	Message        string                                  `json:"message,omitempty"`
+	StartTime      metav1.Time                             `json:"startTime"`
+	CompletionTime *metav1.Time                            `json:"completionTime,omitempty"`
+}
+
+// OpenLibertyApplicationPodRuntimeLogging reports whether the runtime logging overrides are applied to the server of
+// a pod
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationPodRuntimeLogging struct {
+	PodName string                         `json:"podName"`
+	Phase   OpenLibertyRuntimeLoggingPhase `json:"phase"`
+	Message string                         `json:"message,omitempty"`
+}
+
+// OpenLibertyRuntimeLoggingPhase is the phase of the runtime logging overrides of a pod
+type OpenLibertyRuntimeLoggingPhase string
+
+const (
+	// RuntimeLoggingPhaseApplied indicates that the overrides are applied to the server
+	RuntimeLoggingPhaseApplied OpenLibertyRuntimeLoggingPhase = "Applied"
+	// RuntimeLoggingPhasePending indicates that the overrides are applied once the server container runs
+	RuntimeLoggingPhasePending OpenLibertyRuntimeLoggingPhase = "Pending"
+	// RuntimeLoggingPhaseFailed indicates that the overrides could not be applied
+	RuntimeLoggingPhaseFailed OpenLibertyRuntimeLoggingPhase = "Failed"
+)
+
+// OpenLibertyApplicationRemediationPhase is the phase of a remediation
+type OpenLibertyApplicationRemediationPhase string
+
+const (
+	// RemediationPhaseDumping indicates that the remediation waits for the dump to complete
+	RemediationPhaseDumping OpenLibertyApplicationRemediationPhase = "Dumping"
+	// RemediationPhaseCompleted indicates that the action of the remediation ran
+	RemediationPhaseCompleted OpenLibertyApplicationRemediationPhase = "Completed"
+	// RemediationPhaseFailed indicates that the action of the remediation failed
+	RemediationPhaseFailed OpenLibertyApplicationRemediationPhase = "Failed"
+)
+
+const (
+	// StatusConditionTypeReady indicates that the application is serving with all its replicas
+	StatusConditionTypeReady common.StatusConditionType = "Ready"
+	// StatusConditionTypeProgressing indicates that a rollout of the application is in progress
+	StatusConditionTypeProgressing common.StatusConditionType = "Progressing"
+	// StatusConditionTypePaused indicates that the resources of the application are not reconciled
+	StatusConditionTypePaused common.StatusConditionType = "Paused"
+	// StatusConditionTypeApplicationErrors indicates that the servers of the pods logged errors
+	StatusConditionTypeApplicationErrors common.StatusConditionType = "ApplicationErrors"
+	// StatusConditionTypeImageResolved indicates that the digest of the application image was resolved
+	StatusConditionTypeImageResolved common.StatusConditionType = "ImageResolved"
+)
+
+// OpenLibertyApplicationEndpoint is a URL the application is reachable at
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationEndpoint struct {
+	Type OpenLibertyApplicationEndpointType `json:"type"`
+	URI  string                             `json:"uri"`
+}
+
+// OpenLibertyApplicationEndpointType defines the resources that expose the application
+type OpenLibertyApplicationEndpointType string
+
+const (
+	// OpenLibertyApplicationEndpointTypeService the Service, reachable within the cluster
+	OpenLibertyApplicationEndpointTypeService OpenLibertyApplicationEndpointType = "Service"
+	// OpenLibertyApplicationEndpointTypeRoute the OpenShift Route
+	OpenLibertyApplicationEndpointTypeRoute OpenLibertyApplicationEndpointType = "Route"
+	// OpenLibertyApplicationEndpointTypeIngress an Ingress routing to the Service of the application
+	OpenLibertyApplicationEndpointTypeIngress OpenLibertyApplicationEndpointType = "Ingress"
+	// OpenLibertyApplicationEndpointTypeKnativeService the Knative Service
+	OpenLibertyApplicationEndpointTypeKnativeService OpenLibertyApplicationEndpointType = "KnativeService"
+)
+
+// OpenLibertyApplicationResourceReference identifies a resource created for the application
+// +k8s:openapi-gen=true
+type OpenLibertyApplicationResourceReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
 }
 
 // StatusCondition ...
@@ -156,6 +647,13 @@ type ServiceBindingConsumes struct {
 // +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Reconciled')].reason",priority=1,description="Reason for the failure of reconcile condition"
 // +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Reconciled')].message",priority=1,description="Failure message from reconcile condition"
 // +kubebuilder:printcolumn:name="DependenciesSatisfied",type="string",JSONPath=".status.conditions[?(@.type=='DependenciesSatisfied')].status",priority=1,description="Status of the application dependencies"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",priority=0,description="Whether the application is serving with all its replicas"
+// +kubebuilder:printcolumn:name="Progressing",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].status",priority=1,description="Whether a rollout of the application is in progress"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",priority=0,description="Number of replicas requested"
+// +kubebuilder:printcolumn:name="Ready Replicas",type="integer",JSONPath=".status.readyReplicas",priority=0,description="Number of ready replicas"
+// +kubebuilder:printcolumn:name="Resolved Image",type="string",JSONPath=".status.resolvedImage",priority=1,description="Application image pinned to its digest"
+// +kubebuilder:printcolumn:name="Paused",type="string",JSONPath=".status.conditions[?(@.type=='Paused')].status",priority=1,description="Whether the resources of the application are not reconciled"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.endpoints[0].uri",priority=1,description="URL the application is reachable at"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0,description="Age of the resource"
 type OpenLibertyApplication struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -205,14 +703,50 @@ func (cr *OpenLibertyApplication) GetReplicas() *int32 {
 
 // GetLivenessProbe returns liveness probe
 func (cr *OpenLibertyApplication) GetLivenessProbe() *corev1.Probe {
+	if cr.Spec.LivenessProbe == nil && cr.GetProbes().GetDefaults() == OpenLibertyApplicationProbesDefaultsMPHealth {
+		return cr.mpHealthProbe("/health/live", 0, 10, 3)
+	}
 	return cr.Spec.LivenessProbe
 }
 
 // GetReadinessProbe returns readiness probe
 func (cr *OpenLibertyApplication) GetReadinessProbe() *corev1.Probe {
+	if cr.Spec.ReadinessProbe == nil && cr.GetProbes().GetDefaults() == OpenLibertyApplicationProbesDefaultsMPHealth {
+		return cr.mpHealthProbe("/health/ready", 10, 5, 3)
+	}
 	return cr.Spec.ReadinessProbe
 }
 
+// GetStartupProbe returns startup probe. Only its timing fields are set, as its handler is never run.
+func (cr *OpenLibertyApplication) GetStartupProbe() *corev1.Probe {
+	if cr.Spec.StartupProbe == nil && cr.GetProbes().GetDefaults() == OpenLibertyApplicationProbesDefaultsMPHealth {
+		return &corev1.Probe{PeriodSeconds: 5, FailureThreshold: 24}
+	}
+	return cr.Spec.StartupProbe
+}
+
+// GetProbes returns probe settings
+func (cr *OpenLibertyApplication) GetProbes() *OpenLibertyApplicationProbes {
+	return cr.Spec.Probes
+}
+
+// mpHealthProbe returns a probe against a MicroProfile Health endpoint on the service port
+func (cr *OpenLibertyApplication) mpHealthProbe(path string, initialDelay, period, failureThreshold int32) *corev1.Probe {
+	return &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: path,
+				Port: intstr.FromInt(int(cr.Spec.Service.GetPort())),
+			},
+		},
+		InitialDelaySeconds: initialDelay,
+		TimeoutSeconds:      2,
+		PeriodSeconds:       period,
+		SuccessThreshold:    1,
+		FailureThreshold:    failureThreshold,
+	}
+}
+
 // GetVolumes returns volumes slice
 func (cr *OpenLibertyApplication) GetVolumes() []corev1.Volume {
 	return cr.Spec.Volumes
@@ -365,6 +899,104 @@ func (cr *OpenLibertyApplication) GetServiceability() *OpenLibertyApplicationSer
 	return cr.Spec.Serviceability
 }
 
+// GetDefaults returns the mode used to generate probes that are not set explicitly
+func (p *OpenLibertyApplicationProbes) GetDefaults() OpenLibertyApplicationProbesDefaults {
+	if p == nil {
+		return ""
+	}
+	return p.Defaults
+}
+
+// GetSessionCache returns session cache settings
+func (cr *OpenLibertyApplication) GetSessionCache() *OpenLibertyApplicationSessionCache {
+	return cr.Spec.SessionCache
+}
+
+// GetProvider returns the JCache provider used for session caching
+func (s *OpenLibertyApplicationSessionCache) GetProvider() OpenLibertyApplicationSessionCacheProvider {
+	if s.Provider == "" {
+		return OpenLibertyApplicationSessionCacheProviderHazelcast
+	}
+	return s.Provider
+}
+
+// GetMode returns whether pods are members or clients of the session cache cluster
+func (s *OpenLibertyApplicationSessionCache) GetMode() OpenLibertyApplicationSessionCacheMode {
+	if s.Mode == "" {
+		return OpenLibertyApplicationSessionCacheModeEmbedded
+	}
+	return s.Mode
+}
+
+// GetLibraryPath returns the directory containing the JCache provider libraries
+func (s *OpenLibertyApplicationSessionCache) GetLibraryPath() string {
+	if s.LibraryPath == "" {
+		return "/opt/ol/sessioncache/lib"
+	}
+	return s.LibraryPath
+}
+
+// GetSSO returns single sign-on settings
+func (cr *OpenLibertyApplication) GetSSO() *OpenLibertyApplicationSSO {
+	return cr.Spec.SSO
+}
+
+// GetLTPA returns the LTPA keys configuration
+func (cr *OpenLibertyApplication) GetLTPA() *OpenLibertyApplicationLTPA {
+	return cr.Spec.LTPA
+}
+
+// GetScope returns which applications share the LTPA keys
+func (l *OpenLibertyApplicationLTPA) GetScope() OpenLibertyApplicationLTPAScope {
+	if l.Scope == "" {
+		return OpenLibertyApplicationLTPAScopeApplication
+	}
+	return l.Scope
+}
+
+// GetImageDigest returns the image digest resolution settings
+func (cr *OpenLibertyApplication) GetImageDigest() *OpenLibertyApplicationImageDigest {
+	return cr.Spec.ImageDigest
+}
+
+// GetArtifacts returns the files downloaded when the pods start
+func (cr *OpenLibertyApplication) GetArtifacts() []OpenLibertyApplicationArtifact {
+	return cr.Spec.Artifacts
+}
+
+// GetLogWatcher returns the settings of the log watcher
+func (cr *OpenLibertyApplication) GetLogWatcher() *OpenLibertyApplicationLogWatcher {
+	return cr.Spec.LogWatcher
+}
+
+// GetRemediation returns the remediation rules of the pods
+func (cr *OpenLibertyApplication) GetRemediation() *OpenLibertyApplicationRemediation {
+	return cr.Spec.Remediation
+}
+
+// GetLogging returns the logging configuration of the server
+func (cr *OpenLibertyApplication) GetLogging() *OpenLibertyApplicationLogging {
+	return cr.Spec.Logging
+}
+
+// GetDirectory returns the directory the artifact is saved in
+func (a *OpenLibertyApplicationArtifact) GetDirectory() OpenLibertyApplicationArtifactDirectory {
+	if a.Directory == "" {
+		return OpenLibertyApplicationArtifactDirectoryDropins
+	}
+	return a.Directory
+}
+
+// GetShutdown returns how the pods stop
+func (cr *OpenLibertyApplication) GetShutdown() *OpenLibertyApplicationShutdown {
+	return cr.Spec.Shutdown
+}
+
+// GetSidecarContainers returns the containers to run next to the application container
+func (cr *OpenLibertyApplication) GetSidecarContainers() []corev1.Container {
+	return cr.Spec.SidecarContainers
+}
+
 // GetSize returns pesistent volume size for Serviceability
 func (s *OpenLibertyApplicationServiceability) GetSize() string {
 	return s.Size
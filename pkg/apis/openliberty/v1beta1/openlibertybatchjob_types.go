@@ -0,0 +1,117 @@
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenLibertyBatchJobSpec defines the desired state of OpenLibertyBatchJob
+// +k8s:openapi-gen=true
+type OpenLibertyBatchJobSpec struct {
+	ApplicationImage   string             `json:"applicationImage"`
+	PullPolicy         *corev1.PullPolicy `json:"pullPolicy,omitempty"`
+	PullSecret         *string            `json:"pullSecret,omitempty"`
+	ServiceAccountName *string            `json:"serviceAccountName,omitempty"`
+	// Name of the application holding the batch job, as deployed in the server
+	ApplicationName string `json:"applicationName"`
+	// Name of the job XML file, without the .xml extension
+	JobXMLName    string            `json:"jobXMLName"`
+	JobParameters map[string]string `json:"jobParameters,omitempty"`
+	// Name of the Secret holding the username and password of a user allowed to submit batch jobs
+	CredentialsSecret string `json:"credentialsSecret"`
+	// HTTPS port of the server the job is submitted to. Defaults to 9443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	VolumeMounts        []corev1.VolumeMount         `json:"volumeMounts,omitempty"`
+	ResourceConstraints *corev1.ResourceRequirements `json:"resourceConstraints,omitempty"`
+	// +listType=atomic
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Number of retries of the Kubernetes Job before the batch job is reported as failed. Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	BackoffLimit          *int32                             `json:"backoffLimit,omitempty"`
+	ActiveDeadlineSeconds *int64                             `json:"activeDeadlineSeconds,omitempty"`
+	Serviceability        *OpenLibertyBatchJobServiceability `json:"serviceability,omitempty"`
+}
+
+// OpenLibertyBatchJobServiceability selects the volume the job logs are collected to
+// +k8s:openapi-gen=true
+type OpenLibertyBatchJobServiceability struct {
+	// +kubebuilder:validation:Pattern=.+
+	VolumeClaimName string `json:"volumeClaimName"`
+}
+
+// OpenLibertyBatchJobStatus defines the observed state of OpenLibertyBatchJob
+// +k8s:openapi-gen=true
+type OpenLibertyBatchJobStatus struct {
+	// +listType=atomic
+	Conditions []OperationStatusCondition `json:"conditions,omitempty"`
+	// Name of the Kubernetes Job running the batch job
+	JobName   string                        `json:"jobName,omitempty"`
+	Execution *OpenLibertyBatchJobExecution `json:"execution,omitempty"`
+	// Path of the job log in the serviceability volume
+	LogFile string `json:"logFile,omitempty"`
+}
+
+// OpenLibertyBatchJobExecution reports the outcome of an execution of a batch job
+// +k8s:openapi-gen=true
+type OpenLibertyBatchJobExecution struct {
+	InstanceID  int64  `json:"instanceId,omitempty"`
+	ExecutionID int64  `json:"executionId,omitempty"`
+	BatchStatus string `json:"batchStatus,omitempty"`
+	ExitStatus  string `json:"exitStatus,omitempty"`
+	// +listType=atomic
+	Steps []OpenLibertyBatchJobStepExecution `json:"steps,omitempty"`
+}
+
+// OpenLibertyBatchJobStepExecution reports the outcome of a step of a batch job
+// +k8s:openapi-gen=true
+type OpenLibertyBatchJobStepExecution struct {
+	Name        string `json:"name"`
+	BatchStatus string `json:"batchStatus,omitempty"`
+	ExitStatus  string `json:"exitStatus,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyBatchJob is the Schema for the openlibertybatchjobs API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=openlibertybatchjobs,scope=Namespaced,shortName=olbatch;olbatches
+// +kubebuilder:printcolumn:name="Job XML",type="string",JSONPath=".spec.jobXMLName",priority=0,description="Name of the job XML file"
+// +kubebuilder:printcolumn:name="Started",type="string",JSONPath=".status.conditions[?(@.type=='Started')].status",priority=0,description="Indicates if the batch job has started"
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status",priority=0,description="Indicates if the batch job has completed"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].reason",priority=1,description="Reason for the batch job failing to complete"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].message",priority=1,description="Message for the batch job failing to complete"
+// +kubebuilder:printcolumn:name="Execution",type="integer",JSONPath=".status.execution.executionId",priority=1,description="Execution ID of the batch job"
+// +kubebuilder:printcolumn:name="Batch Status",type="string",JSONPath=".status.execution.batchStatus",priority=0,description="Batch status of the execution"
+// +kubebuilder:printcolumn:name="Exit Status",type="string",JSONPath=".status.execution.exitStatus",priority=0,description="Exit status of the execution"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0,description="Age of the resource"
+type OpenLibertyBatchJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenLibertyBatchJobSpec   `json:"spec,omitempty"`
+	Status OpenLibertyBatchJobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyBatchJobList contains a list of OpenLibertyBatchJob
+type OpenLibertyBatchJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyBatchJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyBatchJob{}, &OpenLibertyBatchJobList{})
+}
@@ -0,0 +1,68 @@
+package v1beta1
+
+import (
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenLibertyCronBatchJobSpec defines the desired state of OpenLibertyCronBatchJob
+// +k8s:openapi-gen=true
+type OpenLibertyCronBatchJobSpec struct {
+	// Schedule of the batch job in the Cron format, e.g. "0 2 * * *"
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+	ConcurrencyPolicy batchv1beta1.ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// Set to true to stop scheduling the batch job
+	Suspend *bool `json:"suspend,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	FailedJobsHistoryLimit *int32                  `json:"failedJobsHistoryLimit,omitempty"`
+	JobTemplate            OpenLibertyBatchJobSpec `json:"jobTemplate"`
+}
+
+// OpenLibertyCronBatchJobStatus defines the observed state of OpenLibertyCronBatchJob
+// +k8s:openapi-gen=true
+type OpenLibertyCronBatchJobStatus struct {
+	// Names of the Kubernetes Jobs running the batch job
+	// +listType=set
+	Active           []string     `json:"active,omitempty"`
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// Name of the Kubernetes Job of the last finished execution
+	LastJobName   string                        `json:"lastJobName,omitempty"`
+	LastExecution *OpenLibertyBatchJobExecution `json:"lastExecution,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyCronBatchJob is the Schema for the openlibertycronbatchjobs API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=openlibertycronbatchjobs,scope=Namespaced,shortName=olcronbatch;olcronbatches
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",priority=0,description="Schedule of the batch job"
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend",priority=0,description="Whether the batch job is no longer scheduled"
+// +kubebuilder:printcolumn:name="Last Schedule",type="date",JSONPath=".status.lastScheduleTime",priority=0,description="Last time the batch job was scheduled"
+// +kubebuilder:printcolumn:name="Last Batch Status",type="string",JSONPath=".status.lastExecution.batchStatus",priority=0,description="Batch status of the last finished execution"
+// +kubebuilder:printcolumn:name="Last Exit Status",type="string",JSONPath=".status.lastExecution.exitStatus",priority=1,description="Exit status of the last finished execution"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0,description="Age of the resource"
+type OpenLibertyCronBatchJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenLibertyCronBatchJobSpec   `json:"spec,omitempty"`
+	Status OpenLibertyCronBatchJobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyCronBatchJobList contains a list of OpenLibertyCronBatchJob
+type OpenLibertyCronBatchJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyCronBatchJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyCronBatchJob{}, &OpenLibertyCronBatchJobList{})
+}
@@ -0,0 +1,73 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenLibertyDebugSpec defines the desired state of OpenLibertyDebug
+// +k8s:openapi-gen=true
+type OpenLibertyDebugSpec struct {
+	// Name of the pod to debug. Either podName or applicationName must be set.
+	PodName string `json:"podName,omitempty"`
+	// Name of the OpenLibertyApplication to debug when podName is not set. One of its running pods is debugged.
+	ApplicationName string `json:"applicationName,omitempty"`
+	// How long the debug pod runs before it is removed, e.g. 30m. Defaults to 1h.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	Duration string `json:"duration,omitempty"`
+	// Port of the debug agent. Defaults to 7777.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+	// Set to true for the server to wait for a debugger to attach before it starts
+	Suspend *bool `json:"suspend,omitempty"`
+}
+
+// OpenLibertyDebugStatus defines the observed state of OpenLibertyDebug
+// +k8s:openapi-gen=true
+type OpenLibertyDebugStatus struct {
+	// +listType=atomic
+	Conditions       []OperationStatusCondition `json:"conditions,omitempty"`
+	OperatedResource OperatedResource           `json:"operatedResource,omitempty"`
+	DebugPodName     string                     `json:"debugPodName,omitempty"`
+	DebugPort        int32                      `json:"debugPort,omitempty"`
+	// Command that forwards the debug port to the local host
+	PortForwardCommand string       `json:"portForwardCommand,omitempty"`
+	ExpirationTime     *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyDebug is the Schema for the openlibertydebugs API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=openlibertydebugs,scope=Namespaced,shortName=oldebug;oldebugs
+// +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".status.operatedResource.resourceName",priority=0,description="Name of the debugged pod"
+// +kubebuilder:printcolumn:name="Debug pod",type="string",JSONPath=".status.debugPodName",priority=0,description="Name of the pod running the debug agent"
+// +kubebuilder:printcolumn:name="Started",type="string",JSONPath=".status.conditions[?(@.type=='Started')].status",priority=0,description="Indicates if debug operation has started"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Started')].reason",priority=1,description="Reason for debug operation failing to start"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Started')].message",priority=1,description="Message for debug operation failing to start"
+// +kubebuilder:printcolumn:name="Enabled",type="string",JSONPath=".status.conditions[?(@.type=='Enabled')].status",priority=0,description="Indicates if the debug pod is running"
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status",priority=0,description="Indicates if debug operation has completed"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].reason",priority=1,description="Reason for debug operation completing"
+// +kubebuilder:printcolumn:name="Expires",type="string",JSONPath=".status.expirationTime",priority=1,description="Time at which the debug pod is removed"
+// +kubebuilder:printcolumn:name="Port forward",type="string",JSONPath=".status.portForwardCommand",priority=1,description="Command that forwards the debug port to the local host"
+type OpenLibertyDebug struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenLibertyDebugSpec   `json:"spec,omitempty"`
+	Status OpenLibertyDebugStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyDebugList contains a list of OpenLibertyDebug
+type OpenLibertyDebugList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyDebug `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyDebug{}, &OpenLibertyDebugList{})
+}
@@ -0,0 +1,77 @@
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// OpenLibertyDefaultsName is the name of the OpenLibertyDefaults and OpenLibertyClusterDefaults instances used by the operator
+const OpenLibertyDefaultsName = "default"
+
+// OpenLibertyDefaultsSpec defines the values used by the OpenLibertyApplications that don't set them
+// +k8s:openapi-gen=true
+type OpenLibertyDefaultsSpec struct {
+	PullPolicy          *corev1.PullPolicy            `json:"pullPolicy,omitempty"`
+	PullSecret          *string                       `json:"pullSecret,omitempty"`
+	ServiceAccountName  *string                       `json:"serviceAccountName,omitempty"`
+	ResourceConstraints *corev1.ResourceRequirements  `json:"resourceConstraints,omitempty"`
+	ReadinessProbe      *corev1.Probe                 `json:"readinessProbe,omitempty"`
+	LivenessProbe       *corev1.Probe                 `json:"livenessProbe,omitempty"`
+	StartupProbe        *corev1.Probe                 `json:"startupProbe,omitempty"`
+	Probes              *OpenLibertyApplicationProbes `json:"probes,omitempty"`
+	// +listType=atomic
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// +listType=map
+	// +listMapKey=name
+	Env        []corev1.EnvVar                   `json:"env,omitempty"`
+	Monitoring *OpenLibertyApplicationMonitoring `json:"monitoring,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyDefaults is the Schema for the openlibertydefaults API
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=openlibertydefaults,scope=Namespaced,shortName=oldefaults
+type OpenLibertyDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OpenLibertyDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyDefaultsList contains a list of OpenLibertyDefaults
+type OpenLibertyDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyDefaults `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyClusterDefaults is the Schema for the openlibertyclusterdefaults API
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:path=openlibertyclusterdefaults,scope=Cluster,shortName=olclusterdefaults
+type OpenLibertyClusterDefaults struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OpenLibertyDefaultsSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyClusterDefaultsList contains a list of OpenLibertyClusterDefaults
+type OpenLibertyClusterDefaultsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyClusterDefaults `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyDefaults{}, &OpenLibertyDefaultsList{})
+	SchemeBuilder.Register(&OpenLibertyClusterDefaults{}, &OpenLibertyClusterDefaultsList{})
+}
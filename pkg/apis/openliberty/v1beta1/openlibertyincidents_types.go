@@ -0,0 +1,68 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenLibertyIncidentsStatus defines the observed state of OpenLibertyIncidents
+// +k8s:openapi-gen=true
+type OpenLibertyIncidentsStatus struct {
+	// +listType=map
+	// +listMapKey=podName
+	Pods []OpenLibertyIncidentsPod `json:"pods,omitempty"`
+	// Number of incidents of all the pods
+	TotalCount int32        `json:"totalCount,omitempty"`
+	LastSeen   *metav1.Time `json:"lastSeen,omitempty"`
+}
+
+// OpenLibertyIncidentsPod reports the FFDC incidents of the server of a pod
+// +k8s:openapi-gen=true
+type OpenLibertyIncidentsPod struct {
+	PodName string `json:"podName"`
+	// +listType=atomic
+	Incidents []OpenLibertyIncident `json:"incidents"`
+	// Directory of the serviceability volume the incident files are copied to
+	FilesDirectory string `json:"filesDirectory,omitempty"`
+}
+
+// OpenLibertyIncident counts the occurrences of an FFDC incident, identified by its exception and probe
+// +k8s:openapi-gen=true
+type OpenLibertyIncident struct {
+	ExceptionName string `json:"exceptionName"`
+	ProbeID       string `json:"probeID"`
+	// Class that caught the exception
+	ClassName string      `json:"className,omitempty"`
+	Count     int32       `json:"count"`
+	FirstSeen metav1.Time `json:"firstSeen"`
+	LastSeen  metav1.Time `json:"lastSeen"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyIncidents is the Schema for the openlibertyincidents API. The operator creates one for each
+// OpenLibertyApplication that aggregates its FFDC incidents, with the name of the application.
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=openlibertyincidents,scope=Namespaced,shortName=olincidents
+// +kubebuilder:printcolumn:name="Incidents",type="integer",JSONPath=".status.totalCount",priority=0,description="Number of incidents of all the pods"
+// +kubebuilder:printcolumn:name="Last Seen",type="date",JSONPath=".status.lastSeen",priority=0,description="Time of the last incident"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0,description="Age of the resource"
+type OpenLibertyIncidents struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status OpenLibertyIncidentsStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyIncidentsList contains a list of OpenLibertyIncidents
+type OpenLibertyIncidentsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyIncidents `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyIncidents{}, &OpenLibertyIncidentsList{})
+}
@@ -0,0 +1,65 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenLibertyPauseSpec defines the desired state of OpenLibertyPause
+// +k8s:openapi-gen=true
+type OpenLibertyPauseSpec struct {
+	// Name of the pod to pause. Either podName or applicationName must be set.
+	PodName string `json:"podName,omitempty"`
+	// Name of the OpenLibertyApplication whose running pods are paused when podName is not set
+	ApplicationName string `json:"applicationName,omitempty"`
+	// Names of the endpoints and other components to pause. All of them are paused when not set, except
+	// defaultHttpEndpoint when the pods have a liveness probe.
+	// +listType=set
+	Targets []string `json:"targets,omitempty"`
+	// How long the pods stay paused, e.g. 10m. The pods stay paused until the OpenLibertyPause is deleted when not set.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	Duration string `json:"duration,omitempty"`
+}
+
+// OpenLibertyPauseStatus defines the observed state of OpenLibertyPause
+// +k8s:openapi-gen=true
+type OpenLibertyPauseStatus struct {
+	// +listType=atomic
+	Conditions []OperationStatusCondition `json:"conditions,omitempty"`
+	// +listType=set
+	PausedPods     []string     `json:"pausedPods,omitempty"`
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyPause is the Schema for the openlibertypauses API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=openlibertypauses,scope=Namespaced,shortName=olpause;olpauses
+// +kubebuilder:printcolumn:name="Started",type="string",JSONPath=".status.conditions[?(@.type=='Started')].status",priority=0,description="Indicates if pause operation has started"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Started')].reason",priority=1,description="Reason for pause operation failing to start"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Started')].message",priority=1,description="Message for pause operation failing to start"
+// +kubebuilder:printcolumn:name="Enabled",type="string",JSONPath=".status.conditions[?(@.type=='Enabled')].status",priority=0,description="Indicates if the pods are paused"
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status",priority=0,description="Indicates if the pods were resumed after the duration"
+// +kubebuilder:printcolumn:name="Pods",type="string",JSONPath=".status.pausedPods",priority=1,description="Names of the paused pods"
+// +kubebuilder:printcolumn:name="Expires",type="string",JSONPath=".status.expirationTime",priority=1,description="Time at which the pods are resumed"
+type OpenLibertyPause struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenLibertyPauseSpec   `json:"spec,omitempty"`
+	Status OpenLibertyPauseStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyPauseList contains a list of OpenLibertyPause
+type OpenLibertyPauseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyPause `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyPause{}, &OpenLibertyPauseList{})
+}
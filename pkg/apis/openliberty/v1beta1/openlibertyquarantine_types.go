@@ -0,0 +1,70 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenLibertyQuarantineSpec defines the desired state of OpenLibertyQuarantine
+// +k8s:openapi-gen=true
+type OpenLibertyQuarantineSpec struct {
+	// Name of the pod to quarantine. The pod must belong to the Deployment of an application.
+	PodName string `json:"podName"`
+	// How long the quarantined pod is kept before it is deleted, e.g. 2h. Defaults to 1h.
+	// +kubebuilder:validation:Pattern=^([0-9]+(\.[0-9]+)?(ms|s|m|h))+$
+	Duration string `json:"duration,omitempty"`
+	// Requests a dump of the server once the pod is quarantined
+	Dump *OpenLibertyQuarantineDump `json:"dump,omitempty"`
+}
+
+// OpenLibertyQuarantineDump defines the dump requested on the quarantined pod
+// +k8s:openapi-gen=true
+type OpenLibertyQuarantineDump struct {
+	// +listType=set
+	Include []OpenLibertyDumpInclude `json:"include,omitempty"`
+}
+
+// OpenLibertyQuarantineStatus defines the observed state of OpenLibertyQuarantine
+// +k8s:openapi-gen=true
+type OpenLibertyQuarantineStatus struct {
+	// +listType=atomic
+	Conditions       []OperationStatusCondition `json:"conditions,omitempty"`
+	OperatedResource OperatedResource           `json:"operatedResource,omitempty"`
+	// Name of the OpenLibertyDump requested on the quarantined pod
+	DumpName       string       `json:"dumpName,omitempty"`
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyQuarantine is the Schema for the openlibertyquarantines API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=openlibertyquarantines,scope=Namespaced,shortName=olquarantine;olquarantines
+// +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".spec.podName",priority=0,description="Name of the quarantined pod"
+// +kubebuilder:printcolumn:name="Started",type="string",JSONPath=".status.conditions[?(@.type=='Started')].status",priority=0,description="Indicates if quarantine operation has started"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Started')].reason",priority=1,description="Reason for quarantine operation failing to start"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Started')].message",priority=1,description="Message for quarantine operation failing to start"
+// +kubebuilder:printcolumn:name="Enabled",type="string",JSONPath=".status.conditions[?(@.type=='Enabled')].status",priority=0,description="Indicates if the pod is quarantined"
+// +kubebuilder:printcolumn:name="Completed",type="string",JSONPath=".status.conditions[?(@.type=='Completed')].status",priority=0,description="Indicates if the quarantined pod was deleted"
+// +kubebuilder:printcolumn:name="Dump",type="string",JSONPath=".status.dumpName",priority=1,description="Name of the OpenLibertyDump requested on the pod"
+// +kubebuilder:printcolumn:name="Expires",type="string",JSONPath=".status.expirationTime",priority=1,description="Time at which the quarantined pod is deleted"
+type OpenLibertyQuarantine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenLibertyQuarantineSpec   `json:"spec,omitempty"`
+	Status OpenLibertyQuarantineStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpenLibertyQuarantineList contains a list of OpenLibertyQuarantine
+type OpenLibertyQuarantineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenLibertyQuarantine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenLibertyQuarantine{}, &OpenLibertyQuarantineList{})
+}
@@ -39,6 +39,27 @@ func (in *OpenLibertyApplication) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationArtifact) DeepCopyInto(out *OpenLibertyApplicationArtifact) {
+	*out = *in
+	if in.Maven != nil {
+		in, out := &in.Maven, &out.Maven
+		*out = new(OpenLibertyApplicationMavenArtifact)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationArtifact.
+func (in *OpenLibertyApplicationArtifact) DeepCopy() *OpenLibertyApplicationArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenLibertyApplicationAutoScaling) DeepCopyInto(out *OpenLibertyApplicationAutoScaling) {
 	*out = *in
@@ -65,6 +86,70 @@ func (in *OpenLibertyApplicationAutoScaling) DeepCopy() *OpenLibertyApplicationA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationEndpoint) DeepCopyInto(out *OpenLibertyApplicationEndpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationEndpoint.
+func (in *OpenLibertyApplicationEndpoint) DeepCopy() *OpenLibertyApplicationEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationErrorMessage) DeepCopyInto(out *OpenLibertyApplicationErrorMessage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationErrorMessage.
+func (in *OpenLibertyApplicationErrorMessage) DeepCopy() *OpenLibertyApplicationErrorMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationErrorMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationImageDigest) DeepCopyInto(out *OpenLibertyApplicationImageDigest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationImageDigest.
+func (in *OpenLibertyApplicationImageDigest) DeepCopy() *OpenLibertyApplicationImageDigest {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationImageDigest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationLTPA) DeepCopyInto(out *OpenLibertyApplicationLTPA) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationLTPA.
+func (in *OpenLibertyApplicationLTPA) DeepCopy() *OpenLibertyApplicationLTPA {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationLTPA)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenLibertyApplicationList) DeepCopyInto(out *OpenLibertyApplicationList) {
 	*out = *in
@@ -98,6 +183,153 @@ func (in *OpenLibertyApplicationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationLogWatcher) DeepCopyInto(out *OpenLibertyApplicationLogWatcher) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MessageIDs != nil {
+		in, out := &in.MessageIDs, &out.MessageIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FFDC != nil {
+		in, out := &in.FFDC, &out.FFDC
+		*out = new(OpenLibertyApplicationLogWatcherFFDC)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationLogWatcher.
+func (in *OpenLibertyApplicationLogWatcher) DeepCopy() *OpenLibertyApplicationLogWatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationLogWatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationLogWatcherFFDC) DeepCopyInto(out *OpenLibertyApplicationLogWatcherFFDC) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CopyToServiceability != nil {
+		in, out := &in.CopyToServiceability, &out.CopyToServiceability
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationLogWatcherFFDC.
+func (in *OpenLibertyApplicationLogWatcherFFDC) DeepCopy() *OpenLibertyApplicationLogWatcherFFDC {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationLogWatcherFFDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationLogging) DeepCopyInto(out *OpenLibertyApplicationLogging) {
+	*out = *in
+	if in.ConsoleSources != nil {
+		in, out := &in.ConsoleSources, &out.ConsoleSources
+		*out = make([]OpenLibertyLoggingSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxFileSize != nil {
+		in, out := &in.MaxFileSize, &out.MaxFileSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxFiles != nil {
+		in, out := &in.MaxFiles, &out.MaxFiles
+		*out = new(int32)
+		**out = **in
+	}
+	if in.JSONFieldMappings != nil {
+		in, out := &in.JSONFieldMappings, &out.JSONFieldMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RuntimeOverrides != nil {
+		in, out := &in.RuntimeOverrides, &out.RuntimeOverrides
+		*out = new(OpenLibertyApplicationLoggingOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationLogging.
+func (in *OpenLibertyApplicationLogging) DeepCopy() *OpenLibertyApplicationLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationLoggingOverrides) DeepCopyInto(out *OpenLibertyApplicationLoggingOverrides) {
+	*out = *in
+	if in.ConsoleSources != nil {
+		in, out := &in.ConsoleSources, &out.ConsoleSources
+		*out = make([]OpenLibertyLoggingSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.MessageSources != nil {
+		in, out := &in.MessageSources, &out.MessageSources
+		*out = make([]OpenLibertyLoggingSource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationLoggingOverrides.
+func (in *OpenLibertyApplicationLoggingOverrides) DeepCopy() *OpenLibertyApplicationLoggingOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationLoggingOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationMavenArtifact) DeepCopyInto(out *OpenLibertyApplicationMavenArtifact) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationMavenArtifact.
+func (in *OpenLibertyApplicationMavenArtifact) DeepCopy() *OpenLibertyApplicationMavenArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationMavenArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenLibertyApplicationMonitoring) DeepCopyInto(out *OpenLibertyApplicationMonitoring) {
 	*out = *in
@@ -118,253 +350,1732 @@ func (in *OpenLibertyApplicationMonitoring) DeepCopyInto(out *OpenLibertyApplica
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationMonitoring.
-func (in *OpenLibertyApplicationMonitoring) DeepCopy() *OpenLibertyApplicationMonitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationMonitoring.
+func (in *OpenLibertyApplicationMonitoring) DeepCopy() *OpenLibertyApplicationMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationPodErrors) DeepCopyInto(out *OpenLibertyApplicationPodErrors) {
+	*out = *in
+	if in.Messages != nil {
+		in, out := &in.Messages, &out.Messages
+		*out = make([]OpenLibertyApplicationErrorMessage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationPodErrors.
+func (in *OpenLibertyApplicationPodErrors) DeepCopy() *OpenLibertyApplicationPodErrors {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationPodErrors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationPodInventory) DeepCopyInto(out *OpenLibertyApplicationPodInventory) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContextRoots != nil {
+		in, out := &in.ContextRoots, &out.ContextRoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationPodInventory.
+func (in *OpenLibertyApplicationPodInventory) DeepCopy() *OpenLibertyApplicationPodInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationPodInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationPodRuntimeLogging) DeepCopyInto(out *OpenLibertyApplicationPodRuntimeLogging) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationPodRuntimeLogging.
+func (in *OpenLibertyApplicationPodRuntimeLogging) DeepCopy() *OpenLibertyApplicationPodRuntimeLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationPodRuntimeLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationProbes) DeepCopyInto(out *OpenLibertyApplicationProbes) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationProbes.
+func (in *OpenLibertyApplicationProbes) DeepCopy() *OpenLibertyApplicationProbes {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationProbes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationRemediation) DeepCopyInto(out *OpenLibertyApplicationRemediation) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]OpenLibertyApplicationRemediationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationRemediation.
+func (in *OpenLibertyApplicationRemediation) DeepCopy() *OpenLibertyApplicationRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationRemediationDump) DeepCopyInto(out *OpenLibertyApplicationRemediationDump) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]OpenLibertyDumpInclude, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationRemediationDump.
+func (in *OpenLibertyApplicationRemediationDump) DeepCopy() *OpenLibertyApplicationRemediationDump {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationRemediationDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationRemediationRecord) DeepCopyInto(out *OpenLibertyApplicationRemediationRecord) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationRemediationRecord.
+func (in *OpenLibertyApplicationRemediationRecord) DeepCopy() *OpenLibertyApplicationRemediationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationRemediationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationRemediationRule) DeepCopyInto(out *OpenLibertyApplicationRemediationRule) {
+	*out = *in
+	if in.Threshold != nil {
+		in, out := &in.Threshold, &out.Threshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Dump != nil {
+		in, out := &in.Dump, &out.Dump
+		*out = new(OpenLibertyApplicationRemediationDump)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationRemediationRule.
+func (in *OpenLibertyApplicationRemediationRule) DeepCopy() *OpenLibertyApplicationRemediationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationRemediationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationResourceReference) DeepCopyInto(out *OpenLibertyApplicationResourceReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationResourceReference.
+func (in *OpenLibertyApplicationResourceReference) DeepCopy() *OpenLibertyApplicationResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationSSO) DeepCopyInto(out *OpenLibertyApplicationSSO) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = make([]OpenLibertyApplicationSSOOIDC, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GitHub != nil {
+		in, out := &in.GitHub, &out.GitHub
+		*out = new(OpenLibertyApplicationSSOProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Google != nil {
+		in, out := &in.Google, &out.Google
+		*out = new(OpenLibertyApplicationSSOProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenShift != nil {
+		in, out := &in.OpenShift, &out.OpenShift
+		*out = new(OpenLibertyApplicationSSOOpenShift)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MapToUserRegistry != nil {
+		in, out := &in.MapToUserRegistry, &out.MapToUserRegistry
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSSO.
+func (in *OpenLibertyApplicationSSO) DeepCopy() *OpenLibertyApplicationSSO {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationSSO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationSSOOIDC) DeepCopyInto(out *OpenLibertyApplicationSSOOIDC) {
+	*out = *in
+	in.OpenLibertyApplicationSSOProvider.DeepCopyInto(&out.OpenLibertyApplicationSSOProvider)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSSOOIDC.
+func (in *OpenLibertyApplicationSSOOIDC) DeepCopy() *OpenLibertyApplicationSSOOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationSSOOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationSSOOpenShift) DeepCopyInto(out *OpenLibertyApplicationSSOOpenShift) {
+	*out = *in
+	if in.ClientID != nil {
+		in, out := &in.ClientID, &out.ClientID
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSSOOpenShift.
+func (in *OpenLibertyApplicationSSOOpenShift) DeepCopy() *OpenLibertyApplicationSSOOpenShift {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationSSOOpenShift)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationSSOProvider) DeepCopyInto(out *OpenLibertyApplicationSSOProvider) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSSOProvider.
+func (in *OpenLibertyApplicationSSOProvider) DeepCopy() *OpenLibertyApplicationSSOProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationSSOProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationService) DeepCopyInto(out *OpenLibertyApplicationService) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Consumes != nil {
+		in, out := &in.Consumes, &out.Consumes
+		*out = make([]ServiceBindingConsumes, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provides != nil {
+		in, out := &in.Provides, &out.Provides
+		*out = new(ServiceBindingProvides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationService.
+func (in *OpenLibertyApplicationService) DeepCopy() *OpenLibertyApplicationService {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationServiceability) DeepCopyInto(out *OpenLibertyApplicationServiceability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationServiceability.
+func (in *OpenLibertyApplicationServiceability) DeepCopy() *OpenLibertyApplicationServiceability {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationServiceability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationSessionCache) DeepCopyInto(out *OpenLibertyApplicationSessionCache) {
+	*out = *in
+	if in.ServerAddresses != nil {
+		in, out := &in.ServerAddresses, &out.ServerAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSessionCache.
+func (in *OpenLibertyApplicationSessionCache) DeepCopy() *OpenLibertyApplicationSessionCache {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationSessionCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationShutdown) DeepCopyInto(out *OpenLibertyApplicationShutdown) {
+	*out = *in
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationShutdown.
+func (in *OpenLibertyApplicationShutdown) DeepCopy() *OpenLibertyApplicationShutdown {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationShutdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationSpec) DeepCopyInto(out *OpenLibertyApplicationSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(OpenLibertyApplicationAutoScaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullPolicy != nil {
+		in, out := &in.PullPolicy, &out.PullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.PullSecret != nil {
+		in, out := &in.PullSecret, &out.PullSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceConstraints != nil {
+		in, out := &in.ResourceConstraints, &out.ResourceConstraints
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(OpenLibertyApplicationProbes)
+		**out = **in
+	}
+	in.Service.DeepCopyInto(&out.Service)
+	if in.Expose != nil {
+		in, out := &in.Expose, &out.Expose
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(OpenLibertyApplicationStorage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateKnativeService != nil {
+		in, out := &in.CreateKnativeService, &out.CreateKnativeService
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(OpenLibertyApplicationMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreateAppDefinition != nil {
+		in, out := &in.CreateAppDefinition, &out.CreateAppDefinition
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InitContainers != nil {
+		in, out := &in.InitContainers, &out.InitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SidecarContainers != nil {
+		in, out := &in.SidecarContainers, &out.SidecarContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Serviceability != nil {
+		in, out := &in.Serviceability, &out.Serviceability
+		*out = new(OpenLibertyApplicationServiceability)
+		**out = **in
+	}
+	if in.SessionCache != nil {
+		in, out := &in.SessionCache, &out.SessionCache
+		*out = new(OpenLibertyApplicationSessionCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSO != nil {
+		in, out := &in.SSO, &out.SSO
+		*out = new(OpenLibertyApplicationSSO)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LTPA != nil {
+		in, out := &in.LTPA, &out.LTPA
+		*out = new(OpenLibertyApplicationLTPA)
+		**out = **in
+	}
+	if in.ImageDigest != nil {
+		in, out := &in.ImageDigest, &out.ImageDigest
+		*out = new(OpenLibertyApplicationImageDigest)
+		**out = **in
+	}
+	if in.Shutdown != nil {
+		in, out := &in.Shutdown, &out.Shutdown
+		*out = new(OpenLibertyApplicationShutdown)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]OpenLibertyApplicationArtifact, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LogWatcher != nil {
+		in, out := &in.LogWatcher, &out.LogWatcher
+		*out = new(OpenLibertyApplicationLogWatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(OpenLibertyApplicationRemediation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(OpenLibertyApplicationLogging)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PauseReadinessGate != nil {
+		in, out := &in.PauseReadinessGate, &out.PauseReadinessGate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSpec.
+func (in *OpenLibertyApplicationSpec) DeepCopy() *OpenLibertyApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationStatus) DeepCopyInto(out *OpenLibertyApplicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]StatusCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConsumedServices != nil {
+		in, out := &in.ConsumedServices, &out.ConsumedServices
+		*out = make(common.ConsumedServices, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ImageLastResolved != nil {
+		in, out := &in.ImageLastResolved, &out.ImageLastResolved
+		*out = (*in).DeepCopy()
+	}
+	if in.AppliedDefaults != nil {
+		in, out := &in.AppliedDefaults, &out.AppliedDefaults
+		*out = new(OpenLibertyDefaultsSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultsSources != nil {
+		in, out := &in.DefaultsSources, &out.DefaultsSources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]OpenLibertyApplicationEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]OpenLibertyApplicationResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationErrors != nil {
+		in, out := &in.ApplicationErrors, &out.ApplicationErrors
+		*out = make([]OpenLibertyApplicationPodErrors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = make([]OpenLibertyApplicationPodInventory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = make([]OpenLibertyApplicationRemediationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeLogging != nil {
+		in, out := &in.RuntimeLogging, &out.RuntimeLogging
+		*out = make([]OpenLibertyApplicationPodRuntimeLogging, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationStatus.
+func (in *OpenLibertyApplicationStatus) DeepCopy() *OpenLibertyApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyApplicationStorage) DeepCopyInto(out *OpenLibertyApplicationStorage) {
+	*out = *in
+	if in.VolumeClaimTemplate != nil {
+		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
+		*out = new(corev1.PersistentVolumeClaim)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationStorage.
+func (in *OpenLibertyApplicationStorage) DeepCopy() *OpenLibertyApplicationStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyApplicationStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJob) DeepCopyInto(out *OpenLibertyBatchJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJob.
+func (in *OpenLibertyBatchJob) DeepCopy() *OpenLibertyBatchJob {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyBatchJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJobExecution) DeepCopyInto(out *OpenLibertyBatchJobExecution) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]OpenLibertyBatchJobStepExecution, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJobExecution.
+func (in *OpenLibertyBatchJobExecution) DeepCopy() *OpenLibertyBatchJobExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJobExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJobList) DeepCopyInto(out *OpenLibertyBatchJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyBatchJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJobList.
+func (in *OpenLibertyBatchJobList) DeepCopy() *OpenLibertyBatchJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyBatchJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJobServiceability) DeepCopyInto(out *OpenLibertyBatchJobServiceability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJobServiceability.
+func (in *OpenLibertyBatchJobServiceability) DeepCopy() *OpenLibertyBatchJobServiceability {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJobServiceability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJobSpec) DeepCopyInto(out *OpenLibertyBatchJobSpec) {
+	*out = *in
+	if in.PullPolicy != nil {
+		in, out := &in.PullPolicy, &out.PullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.PullSecret != nil {
+		in, out := &in.PullSecret, &out.PullSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.JobParameters != nil {
+		in, out := &in.JobParameters, &out.JobParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceConstraints != nil {
+		in, out := &in.ResourceConstraints, &out.ResourceConstraints
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Serviceability != nil {
+		in, out := &in.Serviceability, &out.Serviceability
+		*out = new(OpenLibertyBatchJobServiceability)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJobSpec.
+func (in *OpenLibertyBatchJobSpec) DeepCopy() *OpenLibertyBatchJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJobStatus) DeepCopyInto(out *OpenLibertyBatchJobStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]OperationStatusCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Execution != nil {
+		in, out := &in.Execution, &out.Execution
+		*out = new(OpenLibertyBatchJobExecution)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJobStatus.
+func (in *OpenLibertyBatchJobStatus) DeepCopy() *OpenLibertyBatchJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyBatchJobStepExecution) DeepCopyInto(out *OpenLibertyBatchJobStepExecution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyBatchJobStepExecution.
+func (in *OpenLibertyBatchJobStepExecution) DeepCopy() *OpenLibertyBatchJobStepExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyBatchJobStepExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyClusterDefaults) DeepCopyInto(out *OpenLibertyClusterDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyClusterDefaults.
+func (in *OpenLibertyClusterDefaults) DeepCopy() *OpenLibertyClusterDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyClusterDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyClusterDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyClusterDefaultsList) DeepCopyInto(out *OpenLibertyClusterDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyClusterDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyClusterDefaultsList.
+func (in *OpenLibertyClusterDefaultsList) DeepCopy() *OpenLibertyClusterDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyClusterDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyClusterDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyCronBatchJob) DeepCopyInto(out *OpenLibertyCronBatchJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyCronBatchJob.
+func (in *OpenLibertyCronBatchJob) DeepCopy() *OpenLibertyCronBatchJob {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyCronBatchJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyCronBatchJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyCronBatchJobList) DeepCopyInto(out *OpenLibertyCronBatchJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyCronBatchJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyCronBatchJobList.
+func (in *OpenLibertyCronBatchJobList) DeepCopy() *OpenLibertyCronBatchJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyCronBatchJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyCronBatchJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyCronBatchJobSpec) DeepCopyInto(out *OpenLibertyCronBatchJobSpec) {
+	*out = *in
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SuccessfulJobsHistoryLimit != nil {
+		in, out := &in.SuccessfulJobsHistoryLimit, &out.SuccessfulJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		in, out := &in.FailedJobsHistoryLimit, &out.FailedJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	in.JobTemplate.DeepCopyInto(&out.JobTemplate)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyCronBatchJobSpec.
+func (in *OpenLibertyCronBatchJobSpec) DeepCopy() *OpenLibertyCronBatchJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyCronBatchJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyCronBatchJobStatus) DeepCopyInto(out *OpenLibertyCronBatchJobStatus) {
+	*out = *in
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastExecution != nil {
+		in, out := &in.LastExecution, &out.LastExecution
+		*out = new(OpenLibertyBatchJobExecution)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyCronBatchJobStatus.
+func (in *OpenLibertyCronBatchJobStatus) DeepCopy() *OpenLibertyCronBatchJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyCronBatchJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDebug) DeepCopyInto(out *OpenLibertyDebug) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDebug.
+func (in *OpenLibertyDebug) DeepCopy() *OpenLibertyDebug {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDebug)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyDebug) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDebugList) DeepCopyInto(out *OpenLibertyDebugList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyDebug, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDebugList.
+func (in *OpenLibertyDebugList) DeepCopy() *OpenLibertyDebugList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDebugList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyDebugList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDebugSpec) DeepCopyInto(out *OpenLibertyDebugSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDebugSpec.
+func (in *OpenLibertyDebugSpec) DeepCopy() *OpenLibertyDebugSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDebugSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDebugStatus) DeepCopyInto(out *OpenLibertyDebugStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]OperationStatusCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.OperatedResource = in.OperatedResource
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDebugStatus.
+func (in *OpenLibertyDebugStatus) DeepCopy() *OpenLibertyDebugStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDebugStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDefaults) DeepCopyInto(out *OpenLibertyDefaults) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDefaults.
+func (in *OpenLibertyDefaults) DeepCopy() *OpenLibertyDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyDefaults) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDefaultsList) DeepCopyInto(out *OpenLibertyDefaultsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyDefaults, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDefaultsList.
+func (in *OpenLibertyDefaultsList) DeepCopy() *OpenLibertyDefaultsList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDefaultsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyDefaultsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDefaultsSpec) DeepCopyInto(out *OpenLibertyDefaultsSpec) {
+	*out = *in
+	if in.PullPolicy != nil {
+		in, out := &in.PullPolicy, &out.PullPolicy
+		*out = new(corev1.PullPolicy)
+		**out = **in
+	}
+	if in.PullSecret != nil {
+		in, out := &in.PullSecret, &out.PullSecret
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountName != nil {
+		in, out := &in.ServiceAccountName, &out.ServiceAccountName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceConstraints != nil {
+		in, out := &in.ResourceConstraints, &out.ResourceConstraints
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(OpenLibertyApplicationProbes)
+		**out = **in
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]corev1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(OpenLibertyApplicationMonitoring)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDefaultsSpec.
+func (in *OpenLibertyDefaultsSpec) DeepCopy() *OpenLibertyDefaultsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDefaultsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDump) DeepCopyInto(out *OpenLibertyDump) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDump.
+func (in *OpenLibertyDump) DeepCopy() *OpenLibertyDump {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyDump) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDumpList) DeepCopyInto(out *OpenLibertyDumpList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyDump, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDumpList.
+func (in *OpenLibertyDumpList) DeepCopy() *OpenLibertyDumpList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDumpList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyDumpList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDumpSpec) DeepCopyInto(out *OpenLibertyDumpSpec) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]OpenLibertyDumpInclude, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDumpSpec.
+func (in *OpenLibertyDumpSpec) DeepCopy() *OpenLibertyDumpSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDumpSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyDumpStatus) DeepCopyInto(out *OpenLibertyDumpStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]OperationStatusCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDumpStatus.
+func (in *OpenLibertyDumpStatus) DeepCopy() *OpenLibertyDumpStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyDumpStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyIncident) DeepCopyInto(out *OpenLibertyIncident) {
+	*out = *in
+	in.FirstSeen.DeepCopyInto(&out.FirstSeen)
+	in.LastSeen.DeepCopyInto(&out.LastSeen)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyIncident.
+func (in *OpenLibertyIncident) DeepCopy() *OpenLibertyIncident {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyIncident)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyIncidents) DeepCopyInto(out *OpenLibertyIncidents) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyIncidents.
+func (in *OpenLibertyIncidents) DeepCopy() *OpenLibertyIncidents {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyIncidents)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyIncidents) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyIncidentsList) DeepCopyInto(out *OpenLibertyIncidentsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyIncidents, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyIncidentsList.
+func (in *OpenLibertyIncidentsList) DeepCopy() *OpenLibertyIncidentsList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyIncidentsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyIncidentsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyIncidentsPod) DeepCopyInto(out *OpenLibertyIncidentsPod) {
+	*out = *in
+	if in.Incidents != nil {
+		in, out := &in.Incidents, &out.Incidents
+		*out = make([]OpenLibertyIncident, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyIncidentsPod.
+func (in *OpenLibertyIncidentsPod) DeepCopy() *OpenLibertyIncidentsPod {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyApplicationMonitoring)
+	out := new(OpenLibertyIncidentsPod)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyApplicationService) DeepCopyInto(out *OpenLibertyApplicationService) {
+func (in *OpenLibertyIncidentsStatus) DeepCopyInto(out *OpenLibertyIncidentsStatus) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]OpenLibertyIncidentsPod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Consumes != nil {
-		in, out := &in.Consumes, &out.Consumes
-		*out = make([]ServiceBindingConsumes, len(*in))
-		copy(*out, *in)
-	}
-	if in.Provides != nil {
-		in, out := &in.Provides, &out.Provides
-		*out = new(ServiceBindingProvides)
-		(*in).DeepCopyInto(*out)
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationService.
-func (in *OpenLibertyApplicationService) DeepCopy() *OpenLibertyApplicationService {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyIncidentsStatus.
+func (in *OpenLibertyIncidentsStatus) DeepCopy() *OpenLibertyIncidentsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyApplicationService)
+	out := new(OpenLibertyIncidentsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyApplicationServiceability) DeepCopyInto(out *OpenLibertyApplicationServiceability) {
+func (in *OpenLibertyPause) DeepCopyInto(out *OpenLibertyPause) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationServiceability.
-func (in *OpenLibertyApplicationServiceability) DeepCopy() *OpenLibertyApplicationServiceability {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyPause.
+func (in *OpenLibertyPause) DeepCopy() *OpenLibertyPause {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyApplicationServiceability)
+	out := new(OpenLibertyPause)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyPause) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyApplicationSpec) DeepCopyInto(out *OpenLibertyApplicationSpec) {
+func (in *OpenLibertyPauseList) DeepCopyInto(out *OpenLibertyPauseList) {
 	*out = *in
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Autoscaling != nil {
-		in, out := &in.Autoscaling, &out.Autoscaling
-		*out = new(OpenLibertyApplicationAutoScaling)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PullPolicy != nil {
-		in, out := &in.PullPolicy, &out.PullPolicy
-		*out = new(corev1.PullPolicy)
-		**out = **in
-	}
-	if in.PullSecret != nil {
-		in, out := &in.PullSecret, &out.PullSecret
-		*out = new(string)
-		**out = **in
-	}
-	if in.Volumes != nil {
-		in, out := &in.Volumes, &out.Volumes
-		*out = make([]corev1.Volume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.VolumeMounts != nil {
-		in, out := &in.VolumeMounts, &out.VolumeMounts
-		*out = make([]corev1.VolumeMount, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ResourceConstraints != nil {
-		in, out := &in.ResourceConstraints, &out.ResourceConstraints
-		*out = new(corev1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ReadinessProbe != nil {
-		in, out := &in.ReadinessProbe, &out.ReadinessProbe
-		*out = new(corev1.Probe)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LivenessProbe != nil {
-		in, out := &in.LivenessProbe, &out.LivenessProbe
-		*out = new(corev1.Probe)
-		(*in).DeepCopyInto(*out)
-	}
-	in.Service.DeepCopyInto(&out.Service)
-	if in.Expose != nil {
-		in, out := &in.Expose, &out.Expose
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EnvFrom != nil {
-		in, out := &in.EnvFrom, &out.EnvFrom
-		*out = make([]corev1.EnvFromSource, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Env != nil {
-		in, out := &in.Env, &out.Env
-		*out = make([]corev1.EnvVar, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ServiceAccountName != nil {
-		in, out := &in.ServiceAccountName, &out.ServiceAccountName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Architecture != nil {
-		in, out := &in.Architecture, &out.Architecture
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(OpenLibertyApplicationStorage)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CreateKnativeService != nil {
-		in, out := &in.CreateKnativeService, &out.CreateKnativeService
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Monitoring != nil {
-		in, out := &in.Monitoring, &out.Monitoring
-		*out = new(OpenLibertyApplicationMonitoring)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CreateAppDefinition != nil {
-		in, out := &in.CreateAppDefinition, &out.CreateAppDefinition
-		*out = new(bool)
-		**out = **in
-	}
-	if in.InitContainers != nil {
-		in, out := &in.InitContainers, &out.InitContainers
-		*out = make([]corev1.Container, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenLibertyPause, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Serviceability != nil {
-		in, out := &in.Serviceability, &out.Serviceability
-		*out = new(OpenLibertyApplicationServiceability)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationSpec.
-func (in *OpenLibertyApplicationSpec) DeepCopy() *OpenLibertyApplicationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyPauseList.
+func (in *OpenLibertyPauseList) DeepCopy() *OpenLibertyPauseList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyApplicationSpec)
+	out := new(OpenLibertyPauseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenLibertyPauseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyApplicationStatus) DeepCopyInto(out *OpenLibertyApplicationStatus) {
+func (in *OpenLibertyPauseSpec) DeepCopyInto(out *OpenLibertyPauseSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]StatusCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ConsumedServices != nil {
-		in, out := &in.ConsumedServices, &out.ConsumedServices
-		*out = make(common.ConsumedServices, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationStatus.
-func (in *OpenLibertyApplicationStatus) DeepCopy() *OpenLibertyApplicationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyPauseSpec.
+func (in *OpenLibertyPauseSpec) DeepCopy() *OpenLibertyPauseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyApplicationStatus)
+	out := new(OpenLibertyPauseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyApplicationStorage) DeepCopyInto(out *OpenLibertyApplicationStorage) {
+func (in *OpenLibertyPauseStatus) DeepCopyInto(out *OpenLibertyPauseStatus) {
 	*out = *in
-	if in.VolumeClaimTemplate != nil {
-		in, out := &in.VolumeClaimTemplate, &out.VolumeClaimTemplate
-		*out = new(corev1.PersistentVolumeClaim)
-		(*in).DeepCopyInto(*out)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]OperationStatusCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PausedPods != nil {
+		in, out := &in.PausedPods, &out.PausedPods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyApplicationStorage.
-func (in *OpenLibertyApplicationStorage) DeepCopy() *OpenLibertyApplicationStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyPauseStatus.
+func (in *OpenLibertyPauseStatus) DeepCopy() *OpenLibertyPauseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyApplicationStorage)
+	out := new(OpenLibertyPauseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyDump) DeepCopyInto(out *OpenLibertyDump) {
+func (in *OpenLibertyQuarantine) DeepCopyInto(out *OpenLibertyQuarantine) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -373,18 +2084,18 @@ func (in *OpenLibertyDump) DeepCopyInto(out *OpenLibertyDump) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDump.
-func (in *OpenLibertyDump) DeepCopy() *OpenLibertyDump {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyQuarantine.
+func (in *OpenLibertyQuarantine) DeepCopy() *OpenLibertyQuarantine {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyDump)
+	out := new(OpenLibertyQuarantine)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenLibertyDump) DeepCopyObject() runtime.Object {
+func (in *OpenLibertyQuarantine) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -392,13 +2103,34 @@ func (in *OpenLibertyDump) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyDumpList) DeepCopyInto(out *OpenLibertyDumpList) {
+func (in *OpenLibertyQuarantineDump) DeepCopyInto(out *OpenLibertyQuarantineDump) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]OpenLibertyDumpInclude, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyQuarantineDump.
+func (in *OpenLibertyQuarantineDump) DeepCopy() *OpenLibertyQuarantineDump {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenLibertyQuarantineDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenLibertyQuarantineList) DeepCopyInto(out *OpenLibertyQuarantineList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]OpenLibertyDump, len(*in))
+		*out = make([]OpenLibertyQuarantine, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -406,18 +2138,18 @@ func (in *OpenLibertyDumpList) DeepCopyInto(out *OpenLibertyDumpList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDumpList.
-func (in *OpenLibertyDumpList) DeepCopy() *OpenLibertyDumpList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyQuarantineList.
+func (in *OpenLibertyQuarantineList) DeepCopy() *OpenLibertyQuarantineList {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyDumpList)
+	out := new(OpenLibertyQuarantineList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OpenLibertyDumpList) DeepCopyObject() runtime.Object {
+func (in *OpenLibertyQuarantineList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -425,28 +2157,28 @@ func (in *OpenLibertyDumpList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyDumpSpec) DeepCopyInto(out *OpenLibertyDumpSpec) {
+func (in *OpenLibertyQuarantineSpec) DeepCopyInto(out *OpenLibertyQuarantineSpec) {
 	*out = *in
-	if in.Include != nil {
-		in, out := &in.Include, &out.Include
-		*out = make([]OpenLibertyDumpInclude, len(*in))
-		copy(*out, *in)
+	if in.Dump != nil {
+		in, out := &in.Dump, &out.Dump
+		*out = new(OpenLibertyQuarantineDump)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDumpSpec.
-func (in *OpenLibertyDumpSpec) DeepCopy() *OpenLibertyDumpSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyQuarantineSpec.
+func (in *OpenLibertyQuarantineSpec) DeepCopy() *OpenLibertyQuarantineSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyDumpSpec)
+	out := new(OpenLibertyQuarantineSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenLibertyDumpStatus) DeepCopyInto(out *OpenLibertyDumpStatus) {
+func (in *OpenLibertyQuarantineStatus) DeepCopyInto(out *OpenLibertyQuarantineStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -455,15 +2187,20 @@ func (in *OpenLibertyDumpStatus) DeepCopyInto(out *OpenLibertyDumpStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.OperatedResource = in.OperatedResource
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyDumpStatus.
-func (in *OpenLibertyDumpStatus) DeepCopy() *OpenLibertyDumpStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenLibertyQuarantineStatus.
+func (in *OpenLibertyQuarantineStatus) DeepCopy() *OpenLibertyQuarantineStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OpenLibertyDumpStatus)
+	out := new(OpenLibertyQuarantineStatus)
 	in.DeepCopyInto(out)
 	return out
 }
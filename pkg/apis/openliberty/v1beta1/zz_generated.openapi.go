@@ -11,24 +11,75 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplication":               schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplication(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationAutoScaling":    schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationAutoScaling(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationService":        schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationService(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationServiceability": schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationServiceability(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSpec":           schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSpec(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStatus":         schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStatus(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStorage":        schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStorage(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyDump":                      schema_pkg_apis_openliberty_v1beta1_OpenLibertyDump(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyDumpSpec":                  schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpSpec(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyDumpStatus":                schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpStatus(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyTrace":                     schema_pkg_apis_openliberty_v1beta1_OpenLibertyTrace(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyTraceSpec":                 schema_pkg_apis_openliberty_v1beta1_OpenLibertyTraceSpec(ref),
-		"./pkg/apis/openliberty/v1beta1.OpenLibertyTraceStatus":               schema_pkg_apis_openliberty_v1beta1_OpenLibertyTraceStatus(ref),
-		"./pkg/apis/openliberty/v1beta1.OperatedResource":                     schema_pkg_apis_openliberty_v1beta1_OperatedResource(ref),
-		"./pkg/apis/openliberty/v1beta1.OperationStatusCondition":             schema_pkg_apis_openliberty_v1beta1_OperationStatusCondition(ref),
-		"./pkg/apis/openliberty/v1beta1.ServiceBindingConsumes":               schema_pkg_apis_openliberty_v1beta1_ServiceBindingConsumes(ref),
-		"./pkg/apis/openliberty/v1beta1.ServiceBindingProvides":               schema_pkg_apis_openliberty_v1beta1_ServiceBindingProvides(ref),
-		"./pkg/apis/openliberty/v1beta1.StatusCondition":                      schema_pkg_apis_openliberty_v1beta1_StatusCondition(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplication":                  schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplication(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationArtifact":          schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationArtifact(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationAutoScaling":       schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationAutoScaling(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationEndpoint":          schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationEndpoint(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationErrorMessage":      schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationErrorMessage(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationImageDigest":       schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationImageDigest(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLTPA":              schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLTPA(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogWatcher":        schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLogWatcher(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogWatcherFFDC":    schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLogWatcherFFDC(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogging":           schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLogging(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLoggingOverrides":  schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLoggingOverrides(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMavenArtifact":     schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationMavenArtifact(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodErrors":         schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationPodErrors(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodInventory":      schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationPodInventory(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodRuntimeLogging": schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationPodRuntimeLogging(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationProbes":            schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationProbes(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediation":       schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediation(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationDump":   schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediationDump(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationRecord": schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediationRecord(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationRule":   schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediationRule(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationResourceReference": schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationResourceReference(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSO":               schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSO(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOOIDC":           schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSOOIDC(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOOpenShift":      schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSOOpenShift(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOProvider":       schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSOProvider(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationService":           schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationService(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationServiceability":    schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationServiceability(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSessionCache":      schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSessionCache(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationShutdown":          schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationShutdown(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSpec":              schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStatus":            schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStorage":           schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStorage(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJob":                     schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJob(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobExecution":            schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobExecution(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobServiceability":       schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobServiceability(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobSpec":                 schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobStatus":               schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobStepExecution":        schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobStepExecution(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyClusterDefaults":              schema_pkg_apis_openliberty_v1beta1_OpenLibertyClusterDefaults(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJob":                 schema_pkg_apis_openliberty_v1beta1_OpenLibertyCronBatchJob(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJobSpec":             schema_pkg_apis_openliberty_v1beta1_OpenLibertyCronBatchJobSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJobStatus":           schema_pkg_apis_openliberty_v1beta1_OpenLibertyCronBatchJobStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDebug":                        schema_pkg_apis_openliberty_v1beta1_OpenLibertyDebug(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDebugSpec":                    schema_pkg_apis_openliberty_v1beta1_OpenLibertyDebugSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDebugStatus":                  schema_pkg_apis_openliberty_v1beta1_OpenLibertyDebugStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDefaults":                     schema_pkg_apis_openliberty_v1beta1_OpenLibertyDefaults(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec":                 schema_pkg_apis_openliberty_v1beta1_OpenLibertyDefaultsSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDump":                         schema_pkg_apis_openliberty_v1beta1_OpenLibertyDump(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDumpSpec":                     schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyDumpStatus":                   schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyIncident":                     schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncident(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyIncidents":                    schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncidents(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyIncidentsPod":                 schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncidentsPod(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyIncidentsStatus":              schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncidentsStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyPause":                        schema_pkg_apis_openliberty_v1beta1_OpenLibertyPause(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyPauseSpec":                    schema_pkg_apis_openliberty_v1beta1_OpenLibertyPauseSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyPauseStatus":                  schema_pkg_apis_openliberty_v1beta1_OpenLibertyPauseStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantine":                   schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantine(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineDump":               schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantineDump(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineSpec":               schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantineSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineStatus":             schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantineStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyTrace":                        schema_pkg_apis_openliberty_v1beta1_OpenLibertyTrace(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyTraceSpec":                    schema_pkg_apis_openliberty_v1beta1_OpenLibertyTraceSpec(ref),
+		"./pkg/apis/openliberty/v1beta1.OpenLibertyTraceStatus":                  schema_pkg_apis_openliberty_v1beta1_OpenLibertyTraceStatus(ref),
+		"./pkg/apis/openliberty/v1beta1.OperatedResource":                        schema_pkg_apis_openliberty_v1beta1_OperatedResource(ref),
+		"./pkg/apis/openliberty/v1beta1.OperationStatusCondition":                schema_pkg_apis_openliberty_v1beta1_OperationStatusCondition(ref),
+		"./pkg/apis/openliberty/v1beta1.ServiceBindingConsumes":                  schema_pkg_apis_openliberty_v1beta1_ServiceBindingConsumes(ref),
+		"./pkg/apis/openliberty/v1beta1.ServiceBindingProvides":                  schema_pkg_apis_openliberty_v1beta1_ServiceBindingProvides(ref),
+		"./pkg/apis/openliberty/v1beta1.StatusCondition":                         schema_pkg_apis_openliberty_v1beta1_StatusCondition(ref),
 	}
 }
 
@@ -76,6 +127,62 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplication(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationArtifact is a file downloaded from a URL or a Maven repository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the file the artifact is saved as, e.g. app.war",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL to download the artifact from. Either url or maven must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maven": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMavenArtifact"),
+						},
+					},
+					"sha256": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expected SHA-256 checksum of the artifact. The checksum published with Maven artifacts is used when it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Secret holding the username and password used to download the artifact",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"directory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory of the server configuration the artifact is saved in. Applications in the apps directory must be configured in server.xml. Defaults to dropins.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMavenArtifact"},
+	}
+}
+
 func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationAutoScaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -107,11 +214,11 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationAutoScaling(ref c
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyApplicationService ...",
+				Description: "OpenLibertyApplicationEndpoint is a URL the application is reachable at",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
@@ -120,73 +227,170 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationService(ref commo
 							Format: "",
 						},
 					},
-					"port": {
+					"uri": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "uri"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationErrorMessage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationErrorMessage counts the messages logged with an ID",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"messageID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"count": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
 							Format: "int32",
 						},
 					},
-					"annotations": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "The last message logged with the ID",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"consumes": {
+				},
+				Required: []string{"messageID", "count"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationImageDigest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationImageDigest ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pollInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval between checks of the registry for a new digest of the image tag, e.g. 10m. The tag is resolved again only when the application image changes when not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLTPA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationLTPA ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"rotationInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval between key rotations, e.g. 720h. Keys are not rotated when not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLogWatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationLogWatcher follows the logs of the pods to report the errors of the server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set to true to follow the logs of the pods and report the errors in the ApplicationErrors condition",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"messageIDs": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "IDs of messages reported as errors in addition to the default ones, e.g. CWWKE0701E",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("./pkg/apis/openliberty/v1beta1.ServiceBindingConsumes"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"provides": {
+					"ffdc": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.ServiceBindingProvides"),
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogWatcherFFDC"),
+						},
+					},
+					"inventory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set to true to report the applications and the features started by the servers of the pods, and how long the servers took to start, in the status",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/openliberty/v1beta1.ServiceBindingConsumes", "./pkg/apis/openliberty/v1beta1.ServiceBindingProvides"},
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogWatcherFFDC"},
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationServiceability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLogWatcherFFDC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyApplicationServiceability ...",
+				Description: "OpenLibertyApplicationLogWatcherFFDC aggregates the FFDC incidents of the pods",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"size": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Set to true to aggregate the FFDC incidents of the pods in an OpenLibertyIncidents named after the application",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"volumeClaimName": {
+					"copyToServiceability": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Set to true to copy the incident files to the serviceability volume",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
@@ -195,111 +399,2647 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationServiceability(re
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyApplicationSpec defines the desired state of OpenLibertyApplication",
+				Description: "OpenLibertyApplicationLogging configures the logs of the server",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"consoleLogLevel": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Level of the messages written to the console. Defaults to info.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"applicationImage": {
+					"consoleSources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Sources written to the console. Defaults to message, accessLog, ffdc and audit.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"replicas": {
+					"consoleFormat": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Format of the console. Defaults to json.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"autoscaling": {
+					"maxFileSize": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationAutoScaling"),
+							Description: "Maximum size of the message and trace log files in MB, 0 for no limit",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"pullPolicy": {
+					"maxFiles": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Maximum number of message and trace log files kept, 0 for no limit",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"pullSecret": {
+					"jsonFieldMappings": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "New names of the fields of the JSON logs, e.g. message: msg. Fields of a source are prefixed with the source, e.g. accessLog:ibm_requestHost: host.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"volumes": {
+					"jsonAccessLogFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fields of the JSON access logs, default or logFormat to use the fields of the access log format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessLogFormat": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format of the access logs of the default HTTP endpoint, e.g. %h %u %t \"%r\" %s %b. Replaces the accessLoggingRef of the defaultHttpEndpoint, so the access logging configured for it in the server configuration is not used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runtimeOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLoggingOverrides"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLoggingOverrides"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationLoggingOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationLoggingOverrides defines logging settings applied to the running servers through a configDropin, without restarting the pods",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"consoleLogLevel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Level of the messages written to the console",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consoleSources": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": "name",
-								"x-kubernetes-list-type":     "map",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Sources written to the console",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Volume"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"volumeMounts": {
+					"messageSources": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": "name",
-								"x-kubernetes-list-type":     "map",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Sources written to the messages.log file",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"resourceConstraints": {
+					"messageFormat": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "Format of the messages.log file",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"readinessProbe": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationMavenArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationMavenArtifact identifies an artifact of a Maven repository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repository": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL of the repository. Defaults to Maven Central.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"artifactId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Release version of the artifact. SNAPSHOT versions are not resolved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extension of the artifact file. Defaults to war.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"classifier": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"groupId", "artifactId", "version"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationPodErrors(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationPodErrors reports the errors logged by the server of a pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"messages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "messageID",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationErrorMessage"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"podName", "messages"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationErrorMessage"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationPodInventory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationPodInventory reports the applications and the features started by the server of a pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"applications": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"contextRoots": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"features": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"startDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time the server took to start, e.g. 5.123s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"podName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationPodRuntimeLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationPodRuntimeLogging reports whether the runtime logging overrides are applied to the server of a pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"podName", "phase"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationProbes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationProbes ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"defaults": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationRemediation defines the rules remediating the pods and the limits that prevent the remediations from cascading",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationRule"),
+									},
+								},
+							},
+						},
+					},
+					"maxConcurrent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum number of pods remediated at the same time. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"minInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Minimum time between the start of two remediations of the application, e.g. 10m. Defaults to 10m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationRule"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediationDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationRemediationDump defines the dump taken by a remediation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"include": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediationRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationRemediationRecord reports a remediation of a pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of messages logged within the period of the rule",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"dumpName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"rule", "podName", "count", "action", "phase", "startTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationRemediationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationRemediationRule remediates a pod that logged a message a number of times within a period",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"messageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the message counted by the rule, e.g. TRAS0112W",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of messages logged by a pod within the period that triggers the rule. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period the messages are counted in, e.g. 5m. Defaults to 5m.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dump": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dump of the server taken through an OpenLibertyDump before the action. Requires the serviceability storage.",
+							Ref:         ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationDump"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action run on the pod, once the dump completed. Defaults to Delete.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "messageID"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationDump"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationResourceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationResourceReference identifies a resource created for the application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationSSO ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"oidc": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "id",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOOIDC"),
+									},
+								},
+							},
+						},
+					},
+					"github": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOProvider"),
+						},
+					},
+					"google": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOProvider"),
+						},
+					},
+					"openShift": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOOpenShift"),
+						},
+					},
+					"redirectToRPHostAndPort": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mapToUserRegistry": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOOIDC", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOOpenShift", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSOProvider"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSOOIDC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationSSOOIDC represents a generic OpenID Connect provider",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clientId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret that contains the client ID",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"clientSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret that contains the client secret",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"discoveryEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userNameAttribute": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"clientId", "clientSecret", "id", "discoveryEndpoint"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSOOpenShift(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationSSOOpenShift represents the OpenShift OAuth server. The service account of the application is used as the OAuth client unless a client is specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clientId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret that contains the client ID",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"clientSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret that contains the client secret",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSSOProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationSSOProvider represents the client registered with a login provider",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clientId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret that contains the client ID",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"clientSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret that contains the client secret",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+				Required: []string{"clientId", "clientSecret"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationService ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"consumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.ServiceBindingConsumes"),
+									},
+								},
+							},
+						},
+					},
+					"provides": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.ServiceBindingProvides"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.ServiceBindingConsumes", "./pkg/apis/openliberty/v1beta1.ServiceBindingProvides"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationServiceability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationServiceability ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"volumeClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSessionCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationSessionCache ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"libraryImage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"libraryPath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"serverAddresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationShutdown(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationShutdown configures how the pods stop, so that the requests in progress complete",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"drainDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay before the server stops, so that the pod is removed from the endpoints of the Services and routers first, e.g. 10s. Defaults to 10s when the application is exposed and to 5s otherwise. Set to 0s to disable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stopTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time allowed for the server to stop once the drain delay elapsed, e.g. 30s. Defaults to 30s, the time Liberty waits for the requests in progress to complete. Set to 0s to let the container stop without running server stop.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"terminationGracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time after which the pod is killed once it starts terminating. Defaults to the sum of the drain delay and stop timeout, plus 5 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationSpec defines the desired state of OpenLibertyApplication",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"applicationImage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationAutoScaling"),
+						},
+					},
+					"pullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"pullSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"resourceConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"readinessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"livenessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"probes": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationProbes"),
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationService"),
+						},
+					},
+					"expose": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"envFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"architecture": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStorage"),
+						},
+					},
+					"createKnativeService": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"monitoring": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMonitoring"),
+						},
+					},
+					"createAppDefinition": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"initContainers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"sidecarContainers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Container"),
+									},
+								},
+							},
+						},
+					},
+					"serviceability": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationServiceability"),
+						},
+					},
+					"sessionCache": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSessionCache"),
+						},
+					},
+					"sso": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSO"),
+						},
+					},
+					"ltpa": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLTPA"),
+						},
+					},
+					"imageDigest": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationImageDigest"),
+						},
+					},
+					"shutdown": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationShutdown"),
+						},
+					},
+					"artifacts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Application files downloaded into the server configuration when the pods start",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationArtifact"),
+									},
+								},
+							},
+						},
+					},
+					"logWatcher": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogWatcher"),
+						},
+					},
+					"remediation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rules remediating the pods that log messages, which require the log watcher",
+							Ref:         ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediation"),
+						},
+					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogging"),
+						},
+					},
+					"pauseReadinessGate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Adds a readiness gate to the pods, so that the pods paused by an OpenLibertyPause are not ready. New pods only become ready once the operator reported that they are not paused.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stops the changes to the resources of the application, which are reported as drift in the Paused condition",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"applicationImage"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationArtifact", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationAutoScaling", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationImageDigest", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLTPA", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogWatcher", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationLogging", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMonitoring", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationProbes", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediation", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSSO", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationService", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationServiceability", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationSessionCache", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationShutdown", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStorage", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationStatus defines the observed state of OpenLibertyApplication",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "type",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.StatusCondition"),
+									},
+								},
+							},
+						},
+					},
+					"consumedServices": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Type:   []string{"string"},
+													Format: "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"imageReference": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The application image that was resolved to ResolvedImage",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolvedImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The application image pinned to its digest",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageLastResolved": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"appliedDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The values taken from OpenLibertyDefaults and OpenLibertyClusterDefaults",
+							Ref:         ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec"),
+						},
+					},
+					"defaultsSources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The generation of the application that was last reconciled",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of replicas requested and ready",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"readyReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"endpoints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "type",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationEndpoint"),
+									},
+								},
+							},
+						},
+					},
+					"references": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationResourceReference"),
+									},
+								},
+							},
+						},
+					},
+					"applicationErrors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "podName",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Errors logged by the servers of the pods, when the log watcher is enabled",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodErrors"),
+									},
+								},
+							},
+						},
+					},
+					"inventory": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "podName",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Applications and features started by the servers of the pods, when the inventory of the log watcher is enabled",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodInventory"),
+									},
+								},
+							},
+						},
+					},
+					"remediations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The last remediations of the pods, from the oldest",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationRecord"),
+									},
+								},
+							},
+						},
+					},
+					"runtimeLogging": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "podName",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Runtime logging overrides applied to the servers of the pods",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodRuntimeLogging"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationEndpoint", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodErrors", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodInventory", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationPodRuntimeLogging", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationRemediationRecord", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationResourceReference", "./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec", "./pkg/apis/openliberty/v1beta1.StatusCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyApplicationStorage ...",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"mountPath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"volumeClaimTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.PersistentVolumeClaim"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.PersistentVolumeClaim"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyBatchJob is the Schema for the openlibertybatchjobs API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobExecution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyBatchJobExecution reports the outcome of an execution of a batch job",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"instanceId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"executionId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"batchStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"exitStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobStepExecution"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobStepExecution"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobServiceability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyBatchJobServiceability selects the volume the job logs are collected to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"volumeClaimName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyBatchJobSpec defines the desired state of OpenLibertyBatchJob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"applicationImage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"pullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"pullSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"applicationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the application holding the batch job, as deployed in the server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobXMLName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the job XML file, without the .xml extension",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jobParameters": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"credentialsSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Secret holding the username and password of a user allowed to submit batch jobs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPS port of the server the job is submitted to. Defaults to 9443.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"resourceConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"envFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"backoffLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of retries of the Kubernetes Job before the batch job is reported as failed. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"serviceability": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobServiceability"),
+						},
+					},
+				},
+				Required: []string{"applicationImage", "applicationName", "jobXMLName", "credentialsSecret"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobServiceability", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyBatchJobStatus defines the observed state of OpenLibertyBatchJob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OperationStatusCondition"),
+									},
+								},
+							},
+						},
+					},
+					"jobName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Kubernetes Job running the batch job",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"execution": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobExecution"),
+						},
+					},
+					"logFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path of the job log in the serviceability volume",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobExecution", "./pkg/apis/openliberty/v1beta1.OperationStatusCondition"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyBatchJobStepExecution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyBatchJobStepExecution reports the outcome of a step of a batch job",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"batchStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"exitStatus": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyClusterDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyClusterDefaults is the Schema for the openlibertyclusterdefaults API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyCronBatchJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyCronBatchJob is the Schema for the openlibertycronbatchjobs API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJobSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJobStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJobSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyCronBatchJobStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyCronBatchJobSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyCronBatchJobSpec defines the desired state of OpenLibertyCronBatchJob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule of the batch job in the Cron format, e.g. \"0 2 * * *\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"concurrencyPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set to true to stop scheduling the batch job",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"successfulJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"failedJobsHistoryLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"jobTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobSpec"),
+						},
+					},
+				},
+				Required: []string{"schedule", "jobTemplate"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobSpec"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyCronBatchJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyCronBatchJobStatus defines the observed state of OpenLibertyCronBatchJob",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"active": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of the Kubernetes Jobs running the batch job",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastJobName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Kubernetes Job of the last finished execution",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastExecution": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobExecution"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyBatchJobExecution", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDebug(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDebug is the Schema for the openlibertydebugs API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDebugSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDebugStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyDebugSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyDebugStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDebugSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDebugSpec defines the desired state of OpenLibertyDebug",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the pod to debug. Either podName or applicationName must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"applicationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the OpenLibertyApplication to debug when podName is not set. One of its running pods is debugged.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long the debug pod runs before it is removed, e.g. 30m. Defaults to 1h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port of the debug agent. Defaults to 7777.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set to true for the server to wait for a debugger to attach before it starts",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDebugStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDebugStatus defines the observed state of OpenLibertyDebug",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OperationStatusCondition"),
+									},
+								},
+							},
+						},
+					},
+					"operatedResource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OperatedResource"),
+						},
+					},
+					"debugPodName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"debugPort": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"portForwardCommand": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command that forwards the debug port to the local host",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OperatedResource", "./pkg/apis/openliberty/v1beta1.OperationStatusCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDefaults is the Schema for the openlibertydefaults API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyDefaultsSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDefaultsSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDefaultsSpec defines the values used by the OpenLibertyApplications that don't set them",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"pullSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"serviceAccountName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resourceConstraints": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"readinessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"livenessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"probes": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationProbes"),
+						},
+					},
+					"envFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-type":     "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"monitoring": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMonitoring"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMonitoring", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationProbes", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDump is the Schema for the openlibertydumps API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDumpSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDumpStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyDumpSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyDumpStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDumpSpec defines the desired state of OpenLibertyDump",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"include": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"podName"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyDumpStatus defines the observed state of OpenLibertyDump",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OperationStatusCondition"),
+									},
+								},
+							},
+						},
+					},
+					"dumpFile": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OperationStatusCondition"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncident(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyIncident counts the occurrences of an FFDC incident, identified by its exception and probe",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"exceptionName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"probeID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"className": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class that caught the exception",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"firstSeen": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSeen": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"exceptionName", "probeID", "count", "firstSeen", "lastSeen"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncidents(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyIncidents is the Schema for the openlibertyincidents API. The operator creates one for each OpenLibertyApplication that aggregates its FFDC incidents, with the name of the application.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.Probe"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"livenessProbe": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.Probe"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"service": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationService"),
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyIncidentsStatus"),
 						},
 					},
-					"expose": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyIncidentsStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncidentsPod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyIncidentsPod reports the FFDC incidents of the server of a pod",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"envFrom": {
+					"incidents": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
@@ -310,16 +3050,39 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSpec(ref common.R
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.EnvFromSource"),
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyIncident"),
 									},
 								},
 							},
 						},
 					},
-					"env": {
+					"filesDirectory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Directory of the serviceability volume the incident files are copied to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"podName", "incidents"},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyIncident"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyIncidentsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyIncidentsStatus defines the observed state of OpenLibertyIncidents",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pods": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": "name",
+								"x-kubernetes-list-map-keys": "podName",
 								"x-kubernetes-list-type":     "map",
 							},
 						},
@@ -328,102 +3091,140 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationSpec(ref common.R
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyIncidentsPod"),
 									},
 								},
 							},
 						},
 					},
-					"serviceAccountName": {
+					"totalCount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Number of incidents of all the pods",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"architecture": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+					"lastSeen": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyIncidentsPod", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyPause(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyPause is the Schema for the openlibertypauses API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"storage": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStorage"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"createKnativeService": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyPauseSpec"),
 						},
 					},
-					"monitoring": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMonitoring"),
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyPauseStatus"),
 						},
 					},
-					"createAppDefinition": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyPauseSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyPauseStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyPauseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyPauseSpec defines the desired state of OpenLibertyPause",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Name of the pod to pause. Either podName or applicationName must be set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"initContainers": {
+					"applicationName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the OpenLibertyApplication whose running pods are paused when podName is not set",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": "name",
-								"x-kubernetes-list-type":     "map",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Names of the endpoints and other components to pause. All of them are paused when not set, except defaultHttpEndpoint when the pods have a liveness probe.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Container"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"serviceability": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationServiceability"),
+							Description: "How long the pods stay paused, e.g. 10m. The pods stay paused until the OpenLibertyPause is deleted when not set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"applicationImage"},
 			},
 		},
-		Dependencies: []string{
-			"./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationAutoScaling", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationMonitoring", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationService", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationServiceability", "./pkg/apis/openliberty/v1beta1.OpenLibertyApplicationStorage", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyPauseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyApplicationStatus defines the observed state of OpenLibertyApplication",
+				Description: "OpenLibertyPauseStatus defines the observed state of OpenLibertyPause",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": "type",
-								"x-kubernetes-list-type":     "map",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
@@ -431,78 +3232,48 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStatus(ref common
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("./pkg/apis/openliberty/v1beta1.StatusCondition"),
+										Ref: ref("./pkg/apis/openliberty/v1beta1.OperationStatusCondition"),
 									},
 								},
 							},
 						},
 					},
-					"consumedServices": {
+					"pausedPods": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Type:   []string{"string"},
-													Format: "",
-												},
-											},
-										},
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"./pkg/apis/openliberty/v1beta1.StatusCondition"},
-	}
-}
-
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyApplicationStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyApplicationStorage ...",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"size": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"mountPath": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"volumeClaimTemplate": {
+					"expirationTime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/api/core/v1.PersistentVolumeClaim"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.PersistentVolumeClaim"},
+			"./pkg/apis/openliberty/v1beta1.OperationStatusCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyDump is the Schema for the openlibertydumps API",
+				Description: "OpenLibertyQuarantine is the Schema for the openlibertyquarantines API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -526,35 +3297,29 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDump(ref common.ReferenceCal
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDumpSpec"),
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyDumpStatus"),
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/openliberty/v1beta1.OpenLibertyDumpSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyDumpStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineSpec", "./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantineDump(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyDumpSpec defines the desired state of OpenLibertyDump",
+				Description: "OpenLibertyQuarantineDump defines the dump requested on the quarantined pod",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
 					"include": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -574,17 +3339,52 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpSpec(ref common.Referenc
 						},
 					},
 				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenLibertyQuarantineSpec defines the desired state of OpenLibertyQuarantine",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the pod to quarantine. The pod must belong to the Deployment of an application.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long the quarantined pod is kept before it is deleted, e.g. 2h. Defaults to 1h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dump": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requests a dump of the server once the pod is quarantined",
+							Ref:         ref("./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineDump"),
+						},
+					},
+				},
 				Required: []string{"podName"},
 			},
 		},
+		Dependencies: []string{
+			"./pkg/apis/openliberty/v1beta1.OpenLibertyQuarantineDump"},
 	}
 }
 
-func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_openliberty_v1beta1_OpenLibertyQuarantineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenLibertyDumpStatus defines the observed state of OpenLibertyDump",
+				Description: "OpenLibertyQuarantineStatus defines the observed state of OpenLibertyQuarantine",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -604,17 +3404,28 @@ func schema_pkg_apis_openliberty_v1beta1_OpenLibertyDumpStatus(ref common.Refere
 							},
 						},
 					},
-					"dumpFile": {
+					"operatedResource": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("./pkg/apis/openliberty/v1beta1.OperatedResource"),
+						},
+					},
+					"dumpName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the OpenLibertyDump requested on the quarantined pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./pkg/apis/openliberty/v1beta1.OperationStatusCondition"},
+			"./pkg/apis/openliberty/v1beta1.OperatedResource", "./pkg/apis/openliberty/v1beta1.OperationStatusCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -0,0 +1,10 @@
+package controller
+
+import (
+	"github.com/OpenLiberty/open-liberty-operator/pkg/controller/openlibertyquarantine"
+)
+
+func init() {
+	// AddToManagerFuncs is a list of functions to create controllers and add them to a manager.
+	AddToManagerFuncs = append(AddToManagerFuncs, openlibertyquarantine.Add)
+}
@@ -0,0 +1,400 @@
+package openliberty
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	lutils "github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// logFollowRetryDelay is the delay before the logs of a pod are followed again, e.g. after the container restarted
+const logFollowRetryDelay = 10 * time.Second
+
+// logWatcher follows the JSON console logs of the pods of the applications that enable it. It counts the messages
+// reported as errors and the FFDC incidents, and keeps the inventory of the applications and features started by the
+// server. It records an event on the application for each error and sends a generic event so that the application is
+// reconciled and reports them.
+type logWatcher struct {
+	clientset  kubernetes.Interface
+	restConfig *rest.Config
+	recorder   record.EventRecorder
+	events     chan event.GenericEvent
+
+	mu        sync.Mutex
+	followers map[types.NamespacedName]*logFollower
+	// stopped is set once the manager stopped, so that no pod is followed any more
+	stopped bool
+}
+
+// logFollower follows the logs of a pod. Its fields other than stop are guarded by the mutex of the watcher.
+type logFollower struct {
+	pod        types.NamespacedName
+	container  string
+	app        *openlibertyv1beta1.OpenLibertyApplication
+	messageIDs map[string]bool
+	errors     map[string]*openlibertyv1beta1.OpenLibertyApplicationErrorMessage
+	ffdc       bool
+	copyFFDC   bool
+	incidents  map[string]*openlibertyv1beta1.OpenLibertyIncident
+	// The inventory is kept even when it isn't reported, as the messages are only logged when the server starts
+	inventory       *openlibertyv1beta1.OpenLibertyApplicationPodInventory
+	reportInventory bool
+	// The times the messages counted by the remediation rules were logged, within the retention
+	remediationIDs map[string]bool
+	retention      time.Duration
+	messages       map[string][]time.Time
+	// failure is the reason the logs of the pod can't be followed, e.g. because the operator isn't allowed to
+	failure string
+	// copyPending is set when an incident was logged after the incident files were last copied
+	copyPending    bool
+	filesDirectory string
+	stream         io.ReadCloser
+	stopped        bool
+	stop           chan struct{}
+}
+
+// logWatcherReport holds the errors and the FFDC incidents logged by the pods of an application
+type logWatcherReport struct {
+	errors    []openlibertyv1beta1.OpenLibertyApplicationPodErrors
+	incidents []openlibertyv1beta1.OpenLibertyIncidentsPod
+	inventory []openlibertyv1beta1.OpenLibertyApplicationPodInventory
+	// The reasons the logs of the pods can't be followed
+	failures []string
+	// The times of the messages counted by the remediation rules, by pod and by message ID
+	messages map[string]map[string][]time.Time
+}
+
+func newLogWatcher(clientset kubernetes.Interface, restConfig *rest.Config, recorder record.EventRecorder) *logWatcher {
+	return &logWatcher{
+		clientset:  clientset,
+		restConfig: restConfig,
+		recorder:   recorder,
+		events:     make(chan event.GenericEvent, 100),
+		followers:  map[types.NamespacedName]*logFollower{},
+	}
+}
+
+// sync follows the logs of the pods of the application and stops following the pods that are gone, or all of them
+// when the log watcher is disabled
+func (w *logWatcher) sync(la *openlibertyv1beta1.OpenLibertyApplication, pods []corev1.Pod) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	app := types.NamespacedName{Name: la.Name, Namespace: la.Namespace}
+	current := map[types.NamespacedName]bool{}
+	if lutils.IsLogWatcherEnabled(la) && !w.stopped {
+		messageIDs := lutils.GetLogWatcherMessageIDs(la)
+		ffdc, copyFFDC := lutils.IsFFDCEnabled(la), lutils.IsFFDCCopyEnabled(la)
+		reportInventory := lutils.IsInventoryEnabled(la)
+		remediationIDs, retention := lutils.GetRemediationMessageIDs(la), lutils.GetRemediationRetention(la)
+		// The events are recorded on a copy holding only the metadata the recorder needs
+		ref := &openlibertyv1beta1.OpenLibertyApplication{
+			TypeMeta:   metav1.TypeMeta{APIVersion: openlibertyv1beta1.SchemeGroupVersion.String(), Kind: "OpenLibertyApplication"},
+			ObjectMeta: metav1.ObjectMeta{Name: la.Name, Namespace: la.Namespace, UID: la.UID},
+		}
+		for i := range pods {
+			pod := &pods[i]
+			if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			key := types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}
+			current[key] = true
+			if f, ok := w.followers[key]; ok {
+				f.messageIDs, f.app, f.ffdc, f.copyFFDC, f.reportInventory = messageIDs, ref, ffdc, copyFFDC, reportInventory
+				f.remediationIDs, f.retention = remediationIDs, retention
+				continue
+			}
+			f := &logFollower{
+				pod:        key,
+				container:  lutils.GetLibertyContainerName(pod),
+				app:        ref,
+				messageIDs: messageIDs,
+				errors:     map[string]*openlibertyv1beta1.OpenLibertyApplicationErrorMessage{},
+				ffdc:       ffdc,
+				copyFFDC:   copyFFDC,
+				incidents:  map[string]*openlibertyv1beta1.OpenLibertyIncident{},
+				inventory:  &openlibertyv1beta1.OpenLibertyApplicationPodInventory{PodName: pod.Name},
+				stop:       make(chan struct{}),
+
+				reportInventory: reportInventory,
+				remediationIDs:  remediationIDs,
+				retention:       retention,
+				messages:        map[string][]time.Time{},
+			}
+			w.followers[key] = f
+			go w.follow(f)
+		}
+	}
+
+	for key, f := range w.followers {
+		if f.app.Name == app.Name && f.app.Namespace == app.Namespace && !current[key] {
+			w.stopFollower(f)
+		}
+	}
+}
+
+// report returns the errors, the incidents, the inventories and the messages counted by the remediation rules of the
+// pods of the application
+func (w *logWatcher) report(la *openlibertyv1beta1.OpenLibertyApplication) logWatcherReport {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	report := logWatcherReport{messages: map[string]map[string][]time.Time{}}
+	for key, f := range w.followers {
+		if f.app.Name != la.Name || f.app.Namespace != la.Namespace {
+			continue
+		}
+		messages := []openlibertyv1beta1.OpenLibertyApplicationErrorMessage{}
+		for id, m := range f.errors {
+			if f.messageIDs[id] {
+				messages = append(messages, *m)
+			}
+		}
+		if len(messages) > 0 {
+			report.errors = append(report.errors, openlibertyv1beta1.OpenLibertyApplicationPodErrors{PodName: key.Name, Messages: messages})
+		}
+		if f.ffdc && len(f.incidents) > 0 {
+			pod := openlibertyv1beta1.OpenLibertyIncidentsPod{PodName: key.Name, FilesDirectory: f.filesDirectory}
+			for _, i := range f.incidents {
+				pod.Incidents = append(pod.Incidents, *i)
+			}
+			report.incidents = append(report.incidents, pod)
+		}
+		if f.failure != "" {
+			report.failures = append(report.failures, key.Name+": "+f.failure)
+		}
+		inv := f.inventory
+		if f.reportInventory && (len(inv.Applications) > 0 || len(inv.Features) > 0 || inv.StartDuration != "") {
+			report.inventory = append(report.inventory, *inv.DeepCopy())
+		}
+		for id, times := range f.messages {
+			if f.remediationIDs[id] && len(times) > 0 {
+				if report.messages[key.Name] == nil {
+					report.messages[key.Name] = map[string][]time.Time{}
+				}
+				report.messages[key.Name][id] = append([]time.Time{}, times...)
+			}
+		}
+	}
+	return report
+}
+
+// copyFFDCFiles copies the incident files of the pods of the application that logged incidents since they were
+// last copied. It returns the first error, after trying all the pods.
+func (w *logWatcher) copyFFDCFiles(la *openlibertyv1beta1.OpenLibertyApplication) error {
+	w.mu.Lock()
+	pending := []*logFollower{}
+	for _, f := range w.followers {
+		if f.app.Name == la.Name && f.app.Namespace == la.Namespace && f.copyFFDC && f.copyPending {
+			// Incidents logged while copying are copied on the next reconcile
+			f.copyPending = false
+			pending = append(pending, f)
+		}
+	}
+	w.mu.Unlock()
+
+	var firstErr error
+	for _, f := range pending {
+		dir, cmd := lutils.GetFFDCCopyCommand(f.pod.Namespace, f.pod.Name)
+		_, err := lutils.ExecuteCommandInContainer(w.restConfig, f.pod.Name, f.pod.Namespace, f.container, cmd)
+		w.mu.Lock()
+		if err != nil {
+			f.copyPending = true
+			if firstErr == nil {
+				firstErr = fmt.Errorf("Failed to copy the incident files of pod %s: %v", f.pod.Name, err)
+			}
+		} else {
+			f.filesDirectory = dir
+		}
+		w.mu.Unlock()
+	}
+	return firstErr
+}
+
+// stopAll stops following the logs of all the pods, once the manager stopped
+func (w *logWatcher) stopAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.stopped = true
+	for _, f := range w.followers {
+		w.stopFollower(f)
+	}
+}
+
+// stopFollower stops following the logs of a pod. The caller must hold the mutex.
+func (w *logWatcher) stopFollower(f *logFollower) {
+	if f.stopped {
+		return
+	}
+	f.stopped = true
+	close(f.stop)
+	if f.stream != nil {
+		f.stream.Close()
+	}
+	delete(w.followers, f.pod)
+}
+
+// follow reads the logs of the pod until the follower is stopped or the pod is deleted. The logs are read again
+// from the time they stopped when the stream ends, e.g. because the container restarted.
+func (w *logWatcher) follow(f *logFollower) {
+	var since *metav1.Time
+	for {
+		select {
+		case <-f.stop:
+			return
+		default:
+		}
+
+		now := metav1.Now()
+		opts := &corev1.PodLogOptions{Container: f.container, Follow: true, SinceTime: since}
+		stream, err := w.clientset.CoreV1().Pods(f.pod.Namespace).GetLogs(f.pod.Name, opts).Stream()
+		if err != nil {
+			if errors.IsNotFound(err) {
+				w.mu.Lock()
+				w.stopFollower(f)
+				w.mu.Unlock()
+				return
+			}
+			if errors.IsForbidden(err) {
+				// Retrying doesn't help until the permissions of the operator are fixed, so report it in the status
+				log.Error(err, "Not allowed to follow the logs of the pod", "pod", f.pod.String())
+				w.setFailure(f, err.Error())
+			} else {
+				// The container may not be started yet
+				log.V(1).Info("Failed to follow the logs of the pod", "pod", f.pod.String(), "error", err.Error())
+			}
+		} else {
+			since = &now
+			w.mu.Lock()
+			if f.stopped {
+				w.mu.Unlock()
+				stream.Close()
+				return
+			}
+			f.stream = stream
+			w.mu.Unlock()
+			w.setFailure(f, "")
+
+			scanner := bufio.NewScanner(stream)
+			scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+			for scanner.Scan() {
+				w.handleLine(f, scanner.Text())
+				since = &metav1.Time{Time: time.Now()}
+			}
+			stream.Close()
+		}
+
+		select {
+		case <-f.stop:
+			return
+		case <-time.After(logFollowRetryDelay):
+		}
+	}
+}
+
+// setFailure records the reason the logs of the pod can't be followed, and notifies the application when it changed
+func (w *logWatcher) setFailure(f *logFollower, failure string) {
+	w.mu.Lock()
+	changed := !f.stopped && f.failure != failure
+	f.failure = failure
+	app := f.app
+	w.mu.Unlock()
+
+	if changed {
+		w.notify(f, app)
+	}
+}
+
+// handleLine counts the message or the FFDC incident of the line
+func (w *logWatcher) handleLine(f *logFollower, line string) {
+	if m, ok := lutils.ParseLibertyMessage(line); ok {
+		w.handleMessage(f, m)
+	} else if ffdc, ok := lutils.ParseLibertyFFDC(line); ok {
+		w.handleFFDC(f, ffdc)
+	}
+}
+
+// handleMessage counts the message when it is reported as an error or by a remediation rule and updates the
+// inventory, and notifies the application of the changes it reports
+func (w *logWatcher) handleMessage(f *logFollower, m *lutils.LibertyMessage) {
+	w.mu.Lock()
+	if f.stopped {
+		w.mu.Unlock()
+		return
+	}
+	changed := lutils.UpdatePodInventory(f.inventory, m) && f.reportInventory
+	isError := f.messageIDs[m.MessageID]
+	counted := f.remediationIDs[m.MessageID]
+	if counted {
+		t := m.GetTime()
+		times := f.messages[m.MessageID][:0]
+		for _, prev := range f.messages[m.MessageID] {
+			if !prev.Before(t.Add(-f.retention)) {
+				times = append(times, prev)
+			}
+		}
+		f.messages[m.MessageID] = append(times, t)
+	}
+	if isError {
+		e, ok := f.errors[m.MessageID]
+		if !ok {
+			e = &openlibertyv1beta1.OpenLibertyApplicationErrorMessage{MessageID: m.MessageID}
+			f.errors[m.MessageID] = e
+		}
+		e.Count++
+		e.Message = m.Message
+	}
+	app := f.app
+	w.mu.Unlock()
+
+	if isError {
+		w.recorder.Event(app, corev1.EventTypeWarning, "ApplicationError", fmt.Sprintf("Pod %s logged %s", f.pod.Name, m.Message))
+	}
+	if isError || changed || counted {
+		w.notify(f, app)
+	}
+}
+
+// handleFFDC counts the incident when the FFDC incidents are aggregated, and notifies the application
+func (w *logWatcher) handleFFDC(f *logFollower, ffdc *lutils.LibertyFFDC) {
+	w.mu.Lock()
+	if f.stopped || !f.ffdc {
+		w.mu.Unlock()
+		return
+	}
+	seen := ffdc.GetTime()
+	key := ffdc.ExceptionName + "/" + ffdc.ProbeID
+	i, ok := f.incidents[key]
+	if !ok {
+		i = &openlibertyv1beta1.OpenLibertyIncident{ExceptionName: ffdc.ExceptionName, ProbeID: ffdc.ProbeID, FirstSeen: seen}
+		f.incidents[key] = i
+	}
+	i.Count++
+	i.ClassName, i.LastSeen = ffdc.ClassName, seen
+	f.copyPending = f.copyFFDC
+	app := f.app
+	w.mu.Unlock()
+
+	w.notify(f, app)
+}
+
+// notify sends a generic event for the application to be reconciled
+func (w *logWatcher) notify(f *logFollower, app *openlibertyv1beta1.OpenLibertyApplication) {
+	select {
+	case w.events <- event.GenericEvent{Meta: app, Object: app}:
+	case <-f.stop:
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
+	"sort"
+	"time"
 
 	"github.com/appsody/appsody-operator/pkg/common"
 
@@ -16,11 +19,17 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -33,6 +42,9 @@ import (
 
 var log = logf.Log.WithName("controller_openlibertyapplication")
 
+// executeCommandInContainer runs a command in a container of a pod, e.g. to read the generated LTPA keys
+var executeCommandInContainer = lutils.ExecuteCommandInContainer
+
 /**
 * USER ACTION REQUIRED: This is a scaffold file intended for the user to modify with their own Controller
 * business logic.  Delete these comments after modifying this file.*
@@ -47,9 +59,22 @@ func Add(mgr manager.Manager) error {
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager) reconcile.Reconciler {
 	reconciler := &ReconcileOpenLiberty{ReconcilerBase: autils.NewReconcilerBase(mgr.GetClient(), mgr.GetScheme(), mgr.GetConfig(), mgr.GetEventRecorderFor(("open-liberty-operator")))}
+	reconciler.restConfig = mgr.GetConfig()
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		log.Error(err, "Failed to create Clientset. The logs of the pods won't be watched")
+	} else {
+		reconciler.logWatcher = newLogWatcher(clientset, mgr.GetConfig(), mgr.GetEventRecorderFor("open-liberty-operator"))
+	}
 	return reconciler
 }
 
+// NewReconcileOpenLiberty returns a reconciler using the given client, e.g. to render the resources of applications
+// against an in-memory client
+func NewReconcileOpenLiberty(rb autils.ReconcilerBase) *ReconcileOpenLiberty {
+	return &ReconcileOpenLiberty{ReconcilerBase: rb}
+}
+
 // add adds a new Controller to mgr with r as the reconcile.Reconciler
 func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	// Create a new controller
@@ -58,7 +83,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
-	watchNamespaces, err := autils.GetWatchNamespaces()
+	watchNamespaces, err := lutils.GetWatchNamespaces()
 	if err != nil {
 		log.Error(err, "Failed to get watch namespace")
 		os.Exit(1)
@@ -68,23 +93,23 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	for _, ns := range watchNamespaces {
 		watchNamespacesMap[ns] = true
 	}
-	isClusterWide := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
+	watchAllNamespaces := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
 
-	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "isClusterWide", isClusterWide)
+	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "watchAllNamespaces", watchAllNamespaces)
 
 	pred := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			// Ignore updates to CR status in which case metadata.Generation does not change
-			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (watchAllNamespaces || watchNamespacesMap[e.MetaOld.GetNamespace()])
 		},
 		CreateFunc: func(e event.CreateEvent) bool {
-			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
 		},
 		DeleteFunc: func(e event.DeleteEvent) bool {
-			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
 		},
 		GenericFunc: func(e event.GenericEvent) bool {
-			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
 		},
 	}
 
@@ -107,23 +132,49 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	predSubResource := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			// Ignore updates to CR status in which case metadata.Generation does not change
-			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (watchAllNamespaces || watchNamespacesMap[e.MetaOld.GetNamespace()])
 		},
 		CreateFunc: func(e event.CreateEvent) bool {
 			return false
 		},
 		DeleteFunc: func(e event.DeleteEvent) bool {
-			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
 		},
 		GenericFunc: func(e event.GenericEvent) bool {
 			return false
 		},
 	}
 
+	// The status of the application is derived from the status of its workload
+	predWorkload := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return (e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() || isWorkloadStatusChanged(e.ObjectOld, e.ObjectNew)) &&
+				(watchAllNamespaces || watchNamespacesMap[e.MetaOld.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	// Watch for changes to the dumps of the remediations, to run their actions once they complete
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyDump{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
+	})
+	if err != nil {
+		return err
+	}
+
 	err = c.Watch(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestForOwner{
 		IsController: true,
 		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
-	}, predSubResource)
+	}, predWorkload)
 	if err != nil {
 		return err
 	}
@@ -131,7 +182,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	err = c.Watch(&source.Kind{Type: &appsv1.StatefulSet{}}, &handler.EnqueueRequestForOwner{
 		IsController: true,
 		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
-	}, predSubResource)
+	}, predWorkload)
 	if err != nil {
 		return err
 	}
@@ -144,6 +195,14 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	err = c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
+	}, predSubResource)
+	if err != nil {
+		return err
+	}
+
 	err = c.Watch(&source.Kind{Type: &autoscalingv1.HorizontalPodAutoscaler{}}, &handler.EnqueueRequestForOwner{
 		IsController: true,
 		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
@@ -152,6 +211,42 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
+	predDefaults := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.MetaNew.GetName() == openlibertyv1beta1.OpenLibertyDefaultsName && e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() &&
+				(watchAllNamespaces || watchNamespacesMap[e.MetaNew.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return e.Meta.GetName() == openlibertyv1beta1.OpenLibertyDefaultsName && (watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()])
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return e.Meta.GetName() == openlibertyv1beta1.OpenLibertyDefaultsName && (watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()])
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	// Reconcile the applications that use the defaults when the defaults change
+	enqueueApplications := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+			return getApplicationRequests(mgr.GetClient(), a.Meta.GetNamespace())
+		}),
+	}
+
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyDefaults{}}, enqueueApplications, predDefaults)
+	if err != nil {
+		return err
+	}
+
+	// Cluster-scoped defaults can only be read when the operator watches all namespaces
+	if watchAllNamespaces {
+		err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyClusterDefaults{}}, enqueueApplications, predDefaults)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = c.Watch(&source.Kind{Type: &routev1.Route{}}, &handler.EnqueueRequestForOwner{
 		IsController: true,
 		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
@@ -160,7 +255,86 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 	err = c.Watch(&source.Kind{Type: &servingv1alpha1.Service{}}, &handler.EnqueueRequestForOwner{
 		IsController: true,
 		OwnerType:    &openlibertyv1beta1.OpenLibertyApplication{},
-	}, predSubResource)
+	}, predWorkload)
+	// Knative Serving is optional, but without the watch the status of the Knative services is only refreshed on resync
+	if err != nil {
+		log.Error(err, "Failed to watch Knative services, their status won't be refreshed when they change")
+	}
+
+	predIngress := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return watchAllNamespaces || watchNamespacesMap[e.MetaNew.GetNamespace()]
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return watchAllNamespaces || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	// Ingresses are not created by the operator, but their URLs are reported for the applications they route to
+	err = c.Watch(&source.Kind{Type: &networkingv1beta1.Ingress{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+			return getIngressRequests(a.Object)
+		}),
+	}, predIngress)
+	if err != nil {
+		return err
+	}
+
+	// The runtime logging overrides are applied to the servers of the applications once their container runs
+	predServerStarted := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldPod, okOld := e.ObjectOld.(*corev1.Pod)
+			newPod, okNew := e.ObjectNew.(*corev1.Pod)
+			return okOld && okNew && e.MetaNew.GetLabels()["app.kubernetes.io/managed-by"] == "open-liberty-operator" &&
+				lutils.GetLibertyContainerID(newPod) != lutils.GetLibertyContainerID(oldPod) &&
+				(watchAllNamespaces || watchNamespacesMap[e.MetaNew.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return false
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+
+	err = c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+			name := a.Meta.GetLabels()["app.kubernetes.io/instance"]
+			if name == "" {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: a.Meta.GetNamespace()}}}
+		}),
+	}, predServerStarted)
+	if err != nil {
+		return err
+	}
+
+	// The log watcher notifies the applications whose pods logged errors
+	if rol, ok := r.(*ReconcileOpenLiberty); ok && rol.logWatcher != nil {
+		err = c.Watch(&source.Channel{Source: rol.logWatcher.events}, &handler.EnqueueRequestForObject{})
+		if err != nil {
+			return err
+		}
+		// The followers are stopped with the manager, which is restarted when the watched namespaces change
+		err = mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+			<-stop
+			rol.logWatcher.stopAll()
+			return nil
+		}))
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -173,6 +347,17 @@ type ReconcileOpenLiberty struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	autils.ReconcilerBase
+	imageResolver lutils.ImageDigestResolver
+	logWatcher    *logWatcher
+	// restConfig is used to run commands in the pods, and is not set when rendering the resources offline
+	restConfig *rest.Config
+}
+
+// resourceManager creates, updates and deletes the resources of an application
+type resourceManager interface {
+	CreateOrUpdate(obj metav1.Object, owner metav1.Object, reconcile func() error) error
+	DeleteResource(obj runtime.Object) error
+	DeleteResources(resources []runtime.Object) error
 }
 
 // Reconcile reads that state of the cluster for a OpenLiberty object and makes changes based on the state read
@@ -186,6 +371,7 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger.Info("Reconciling OpenLibertyApplication")
+	start := time.Now()
 
 	// Fetch the OpenLiberty instance
 	instance := &openlibertyv1beta1.OpenLibertyApplication{}
@@ -197,11 +383,18 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 			// Request object not found, could have been deleted after reconcile request.
 			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
 			// Return and don't requeue
+			lutils.DeleteApplicationMetrics(request.Namespace, request.Name)
+			if r.logWatcher != nil {
+				r.logWatcher.sync(&openlibertyv1beta1.OpenLibertyApplication{ObjectMeta: metav1.ObjectMeta{Name: request.Name, Namespace: request.Namespace}}, nil)
+			}
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
 		return reconcile.Result{}, err
 	}
+	defer func() {
+		lutils.RecordApplicationReconcile(instance, time.Since(start))
+	}()
 
 	_, err = autils.Validate(instance)
 	// If there's any validation error, don't bother with requeuing
@@ -219,14 +412,36 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 		return reconcile.Result{}, nil
 	}
 
+	// Values from the defaults are not saved in the application, so that later changes to the defaults reach it.
+	// Neither is the default pull policy, which would otherwise take precedence over a pull policy set in the defaults.
+	persisted := instance.DeepCopy()
+	persisted.Initialize()
+	persisted.Spec.PullPolicy = instance.Spec.PullPolicy
+
+	defaults, sources, err := r.getApplicationDefaults(instance)
+	if err != nil {
+		reqLogger.Error(err, "Failed to get the defaults of OpenLibertyApplication")
+		return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+	}
+	instance.Status.AppliedDefaults = lutils.ApplyDefaults(instance, defaults...)
+	instance.Status.DefaultsSources = sources
+
+	// The startup probe of the defaults isn't validated with the application
+	if err = lutils.ValidateStartupProbe(instance.Spec.StartupProbe); err != nil {
+		reqLogger.Error(err, "Error validating the defaults of OpenLibertyApplication")
+		r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		return reconcile.Result{}, nil
+	}
+
 	instance.Initialize()
 
 	currentGen := instance.Generation
-	err = r.GetClient().Update(context.TODO(), instance)
+	err = r.GetClient().Update(context.TODO(), persisted)
 	if err != nil {
 		reqLogger.Error(err, "Error updating OpenLibertyApplication")
 		return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 	}
+	instance.ObjectMeta = persisted.ObjectMeta
 
 	if currentGen == 1 {
 		return reconcile.Result{}, nil
@@ -237,38 +452,73 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 		Namespace: instance.Namespace,
 	}
 
-	result, err := r.ReconcileProvides(instance)
-	if err != nil || result != (reconcile.Result{}) {
-		return result, err
+	// While the application is paused, the changes to its resources are recorded as drift instead of applied
+	var rm resourceManager = r
+	var drift *lutils.DriftRecorder
+	if lutils.IsPaused(instance) {
+		drift = lutils.NewDriftRecorder(r.GetClient())
+		rm = drift
 	}
 
-	result, err = r.ReconcileConsumes(instance)
-	if err != nil || result != (reconcile.Result{}) {
-		return result, err
+	var result reconcile.Result
+	if drift == nil {
+		result, err = r.ReconcileProvides(instance)
+		if err != nil || result != (reconcile.Result{}) {
+			return result, err
+		}
+
+		result, err = r.ReconcileConsumes(instance)
+		if err != nil || result != (reconcile.Result{}) {
+			return result, err
+		}
 	}
 
+	// The resources created for the application and the URLs it is reachable at
+	refs := []openlibertyv1beta1.OpenLibertyApplicationResourceReference{}
+	endpoints := []openlibertyv1beta1.OpenLibertyApplicationEndpoint{}
+
 	if instance.Spec.ServiceAccountName == nil || *instance.Spec.ServiceAccountName == "" {
 		serviceAccount := &corev1.ServiceAccount{ObjectMeta: defaultMeta}
-		err = r.CreateOrUpdate(serviceAccount, instance, func() error {
+		err = rm.CreateOrUpdate(serviceAccount, instance, func() error {
 			autils.CustomizeServiceAccount(serviceAccount, instance)
-			return nil
+			_, err := lutils.CustomizeSSOServiceAccountRedirect(serviceAccount, instance)
+			return err
 		})
 		if err != nil {
 			reqLogger.Error(err, "Failed to reconcile ServiceAccount")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		refs = append(refs, lutils.GetResourceReference(serviceAccount))
 	} else {
 		serviceAccount := &corev1.ServiceAccount{ObjectMeta: defaultMeta}
-		err = r.DeleteResource(serviceAccount)
+		err = rm.DeleteResource(serviceAccount)
 		if err != nil {
 			reqLogger.Error(err, "Failed to delete ServiceAccount")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+
+		// The configured service account isn't owned by the application, only its redirect annotation is managed
+		if drift == nil {
+			serviceAccount = &corev1.ServiceAccount{}
+			err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: *instance.Spec.ServiceAccountName, Namespace: instance.Namespace}, serviceAccount)
+			if err == nil {
+				var changed bool
+				if changed, err = lutils.CustomizeSSOServiceAccountRedirect(serviceAccount, instance); changed {
+					err = r.GetClient().Update(context.TODO(), serviceAccount)
+				}
+			} else if errors.IsNotFound(err) && !lutils.IsSSOServiceAccountClient(instance) {
+				err = nil
+			}
+			if err != nil {
+				reqLogger.Error(err, "Failed to reconcile the redirect annotation of the ServiceAccount")
+				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+			}
+		}
 	}
 
 	if instance.Spec.CreateKnativeService != nil && *instance.Spec.CreateKnativeService {
 		ksvc := &servingv1alpha1.Service{ObjectMeta: defaultMeta}
-		err = r.CreateOrUpdate(ksvc, instance, func() error {
+		err = rm.CreateOrUpdate(ksvc, instance, func() error {
 			autils.CustomizeKnativeService(ksvc, instance)
 			return nil
 		})
@@ -277,22 +527,34 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 			reqLogger.Error(err, "Failed to reconcile Knative Service")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		refs = append(refs, lutils.GetResourceReference(ksvc))
+		if endpoint := lutils.GetKnativeServiceEndpoint(ksvc); endpoint != nil {
+			endpoints = append(endpoints, *endpoint)
+		}
+		lutils.SetKnativeServiceStatus(instance, ksvc)
 
 		// Clean up non-Knative resources
 		resources := []runtime.Object{
 			&corev1.Service{ObjectMeta: defaultMeta},
 			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-headless", Namespace: instance.Namespace}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetSessionCacheConfigMapName(instance), Namespace: instance.Namespace}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetSSOConfigMapName(instance), Namespace: instance.Namespace}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetLoggingConfigMapName(instance), Namespace: instance.Namespace}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetApplicationLTPASecretName(instance), Namespace: instance.Namespace}},
 			&appsv1.Deployment{ObjectMeta: defaultMeta},
 			&appsv1.StatefulSet{ObjectMeta: defaultMeta},
 			&routev1.Route{ObjectMeta: defaultMeta},
 			&autoscalingv1.HorizontalPodAutoscaler{ObjectMeta: defaultMeta},
 		}
-		err = r.DeleteResources(resources)
+		err = rm.DeleteResources(resources)
 		if err != nil {
 			reqLogger.Error(err, "Failed to clean up non-Knative resources")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		lutils.DeleteApplicationReplicas(instance)
 
+		r.setReconciledStatus(instance, refs, endpoints)
+		lutils.SetPausedStatus(instance, drift)
 		return r.ManageSuccess(common.StatusConditionTypeReconciled, instance)
 	}
 
@@ -302,7 +564,7 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 		r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 	} else if ok {
 		ksvc := &servingv1alpha1.Service{ObjectMeta: defaultMeta}
-		err = r.DeleteResource(ksvc)
+		err = rm.DeleteResource(ksvc)
 		if err != nil {
 			reqLogger.Error(err, "Failed to delete Knative Service")
 			r.ManageError(err, common.StatusConditionTypeReconciled, instance)
@@ -312,7 +574,7 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 	}
 
 	svc := &corev1.Service{ObjectMeta: defaultMeta}
-	err = r.CreateOrUpdate(svc, instance, func() error {
+	err = rm.CreateOrUpdate(svc, instance, func() error {
 		autils.CustomizeService(svc, ba)
 		svc.Annotations = instance.Spec.Service.Annotations
 		if instance.Spec.Monitoring != nil {
@@ -328,6 +590,120 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 		reqLogger.Error(err, "Failed to reconcile Service")
 		return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 	}
+	refs = append(refs, lutils.GetResourceReference(svc))
+
+	// The Route is reconciled before the pods as its host is used in the single sign-on configuration
+	routeHostAndPort := ""
+	if ok, err := r.IsGroupVersionSupported(routev1.SchemeGroupVersion.String()); err != nil {
+		reqLogger.Error(err, fmt.Sprintf("Failed to check if %s is supported", routev1.SchemeGroupVersion.String()))
+		r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+	} else if ok {
+		if instance.Spec.Expose != nil && *instance.Spec.Expose {
+			route := &routev1.Route{ObjectMeta: defaultMeta}
+			err = rm.CreateOrUpdate(route, instance, func() error {
+				autils.CustomizeRoute(route, instance)
+				return nil
+			})
+			if err != nil {
+				reqLogger.Error(err, "Failed to reconcile Route")
+				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+			}
+			refs = append(refs, lutils.GetResourceReference(route))
+			if endpoint := lutils.GetRouteEndpoint(route); endpoint != nil {
+				endpoints = append(endpoints, *endpoint)
+			}
+			if route.Spec.Host != "" {
+				routeHostAndPort = "http://" + route.Spec.Host
+				if route.Spec.TLS != nil {
+					routeHostAndPort = "https://" + route.Spec.Host
+				}
+			}
+		} else {
+			route := &routev1.Route{ObjectMeta: defaultMeta}
+			err = rm.DeleteResource(route)
+			if err != nil {
+				reqLogger.Error(err, "Failed to delete Route")
+				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+			}
+		}
+	} else {
+		reqLogger.V(1).Info(fmt.Sprintf("%s is not supported", routev1.SchemeGroupVersion.String()))
+	}
+
+	ingresses := &networkingv1beta1.IngressList{}
+	if err := r.GetClient().List(context.TODO(), ingresses, client.InNamespace(instance.Namespace)); err != nil {
+		reqLogger.V(1).Info("Failed to list Ingresses", "error", err.Error())
+	} else if endpoint := lutils.GetIngressEndpoint(ingresses.Items, svc.Name); endpoint != nil {
+		endpoints = append(endpoints, *endpoint)
+	}
+	endpoints = append(endpoints, lutils.GetServiceEndpoint(svc))
+
+	ssoSettings := lutils.SSOSettings{}
+	ssoConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetSSOConfigMapName(instance), Namespace: instance.Namespace}}
+	if sso := instance.Spec.SSO; sso != nil {
+		ssoSettings.RedirectToRPHostAndPort = sso.RedirectToRPHostAndPort
+		if ssoSettings.RedirectToRPHostAndPort == "" {
+			ssoSettings.RedirectToRPHostAndPort = routeHostAndPort
+		}
+		if sso.OpenShift != nil {
+			dc, err := r.GetDiscoveryClient()
+			if err == nil {
+				ssoSettings.OpenShiftAuthorizationEndpoint, ssoSettings.OpenShiftTokenEndpoint, err = lutils.GetOpenShiftOAuthEndpoints(dc)
+			}
+			if err != nil {
+				reqLogger.Error(err, "Failed to discover the OpenShift OAuth server")
+				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+			}
+
+			serviceAccount := &corev1.ServiceAccount{}
+			err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: lutils.GetServiceAccountName(instance), Namespace: instance.Namespace}, serviceAccount)
+			if err == nil {
+				ssoSettings.ServiceAccountTokenSecret = lutils.GetServiceAccountTokenSecret(serviceAccount)
+				if ssoSettings.ServiceAccountTokenSecret == "" {
+					err = fmt.Errorf("Token secret of service account %s is not available yet", serviceAccount.Name)
+				}
+			}
+			if err != nil {
+				reqLogger.Error(err, "Failed to get the service account token used to log in with OpenShift")
+				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+			}
+		}
+
+		err = rm.CreateOrUpdate(ssoConfigMap, instance, func() error {
+			lutils.CustomizeSSOConfigMap(ssoConfigMap, instance, ssoSettings)
+			return nil
+		})
+		if err != nil {
+			reqLogger.Error(err, "Failed to reconcile single sign-on ConfigMap")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+		refs = append(refs, lutils.GetResourceReference(ssoConfigMap))
+	} else {
+		err = rm.DeleteResource(ssoConfigMap)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete single sign-on ConfigMap")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+	}
+
+	loggingConfigMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetLoggingConfigMapName(instance), Namespace: instance.Namespace}}
+	if lutils.IsLoggingDropinRequired(instance) {
+		err = rm.CreateOrUpdate(loggingConfigMap, instance, func() error {
+			lutils.CustomizeLoggingConfigMap(loggingConfigMap, instance)
+			return nil
+		})
+		if err != nil {
+			reqLogger.Error(err, "Failed to reconcile logging ConfigMap")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+		refs = append(refs, lutils.GetResourceReference(loggingConfigMap))
+	} else {
+		err = rm.DeleteResource(loggingConfigMap)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete logging ConfigMap")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+	}
 
 	if instance.Spec.Serviceability != nil {
 		if instance.Spec.Serviceability.VolumeClaimName != "" {
@@ -338,44 +714,137 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 			}
 		} else {
-			err = r.CreateOrUpdate(lutils.CreateServiceabilityPVC(instance), nil, func() error {
+			pvc := lutils.CreateServiceabilityPVC(instance)
+			err = rm.CreateOrUpdate(pvc, nil, func() error {
 				return nil
 			})
 			if err != nil {
 				reqLogger.Error(err, "Failed to create PersistentVolumeClaim for Serviceability")
 				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 			}
+			refs = append(refs, lutils.GetResourceReference(pvc))
 		}
 	}
 
-	if instance.Spec.Storage != nil {
-		// Delete Deployment if exists
-		deploy := &appsv1.Deployment{ObjectMeta: defaultMeta}
-		err = r.DeleteResource(deploy)
+	if instance.Spec.SessionCache != nil {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetSessionCacheConfigMapName(instance), Namespace: instance.Namespace}}
+		err = rm.CreateOrUpdate(cm, instance, func() error {
+			lutils.CustomizeSessionCacheConfigMap(cm, instance)
+			return nil
+		})
+		if err != nil {
+			reqLogger.Error(err, "Failed to reconcile session cache ConfigMap")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+		refs = append(refs, lutils.GetResourceReference(cm))
+	} else {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetSessionCacheConfigMapName(instance), Namespace: instance.Namespace}}
+		err = rm.DeleteResource(cm)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete session cache ConfigMap")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+	}
+
+	// Delay before the resources that change over time need to be reconciled again
+	requeueAfter := time.Duration(0)
 
+	var ltpaSecret *corev1.Secret
+	if instance.Spec.LTPA == nil || instance.Spec.LTPA.GetScope() == openlibertyv1beta1.OpenLibertyApplicationLTPAScopeNamespace {
+		// Keys shared across the namespace are left for the other applications
+		appSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetApplicationLTPASecretName(instance), Namespace: instance.Namespace}}
+		appJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: appSecret.Name + "-keygen", Namespace: instance.Namespace}}
+		err = rm.DeleteResources([]runtime.Object{appSecret, appJob})
 		if err != nil {
-			reqLogger.Error(err, "Failed to delete Deployment")
+			reqLogger.Error(err, "Failed to delete LTPA keys Secret")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+	}
+	if instance.Spec.LTPA != nil {
+		var delay time.Duration
+		ltpaSecret, delay, err = r.reconcileLTPAKeys(instance, rm)
+		requeueAfter = nextRequeue(requeueAfter, delay)
+		if err != nil {
+			reqLogger.Error(err, "Failed to reconcile LTPA keys")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
-		svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-headless", Namespace: instance.Namespace}}
-		err = r.CreateOrUpdate(svc, instance, func() error {
-			autils.CustomizeService(svc, instance)
-			svc.Spec.ClusterIP = corev1.ClusterIPNone
-			svc.Spec.Type = corev1.ServiceTypeClusterIP
+		refs = append(refs, lutils.GetResourceReference(ltpaSecret))
+	}
+
+	// The headless Service is used by StatefulSets and for discovering embedded session cache members
+	headlesssvc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-headless", Namespace: instance.Namespace}}
+	if instance.Spec.Storage != nil || lutils.IsSessionCacheEmbedded(instance) {
+		err = rm.CreateOrUpdate(headlesssvc, instance, func() error {
+			autils.CustomizeService(headlesssvc, instance)
+			headlesssvc.Spec.ClusterIP = corev1.ClusterIPNone
+			headlesssvc.Spec.Type = corev1.ServiceTypeClusterIP
+			// Cache members need to find each other before they are ready
+			headlesssvc.Spec.PublishNotReadyAddresses = lutils.IsSessionCacheEmbedded(instance)
 			return nil
 		})
 		if err != nil {
 			reqLogger.Error(err, "Failed to reconcile headless Service")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		refs = append(refs, lutils.GetResourceReference(headlesssvc))
+	} else {
+		err = rm.DeleteResource(headlesssvc)
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete headless Service")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
+	}
+
+	if instance.Spec.ImageDigest != nil {
+		due, delay := lutils.IsImageDigestResolutionDue(instance, time.Now())
+		if due {
+			err = r.resolveImageDigest(instance)
+			// The pods keep running the previously resolved image until the registry can be reached
+			if err != nil {
+				reqLogger.Error(err, "Failed to resolve the digest of the application image")
+				lutils.SetStatusCondition(instance, openlibertyv1beta1.StatusConditionTypeImageResolved, corev1.ConditionFalse,
+					"ResolutionFailed", fmt.Sprintf("Failed to resolve the digest of %s: %v", instance.Spec.ApplicationImage, err))
+				delay = time.Minute
+			} else {
+				lutils.SetStatusCondition(instance, openlibertyv1beta1.StatusConditionTypeImageResolved, corev1.ConditionTrue,
+					"Resolved", fmt.Sprintf("Resolved %s to %s", instance.Status.ImageReference, instance.Status.ResolvedImage))
+				_, delay = lutils.IsImageDigestResolutionDue(instance, time.Now())
+			}
+		}
+		requeueAfter = nextRequeue(requeueAfter, delay)
+	} else {
+		instance.Status.ImageReference = ""
+		instance.Status.ResolvedImage = ""
+		instance.Status.ImageLastResolved = nil
+		lutils.RemoveStatusCondition(instance, openlibertyv1beta1.StatusConditionTypeImageResolved)
+	}
 
+	if instance.Spec.Storage != nil {
+		// Delete Deployment if exists
+		deploy := &appsv1.Deployment{ObjectMeta: defaultMeta}
+		err = rm.DeleteResource(deploy)
+
+		if err != nil {
+			reqLogger.Error(err, "Failed to delete Deployment")
+			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+		}
 		statefulSet := &appsv1.StatefulSet{ObjectMeta: defaultMeta}
-		err = r.CreateOrUpdate(statefulSet, instance, func() error {
+		err = rm.CreateOrUpdate(statefulSet, instance, func() error {
 			autils.CustomizeStatefulSet(statefulSet, instance)
 			autils.CustomizePodSpec(&statefulSet.Spec.Template, instance)
+			lutils.CustomizeImageDigest(&statefulSet.Spec.Template, instance)
+			lutils.CustomizeSidecarContainers(&statefulSet.Spec.Template, instance)
+			lutils.CustomizeStartupProbe(&statefulSet.Spec.Template, instance)
+			lutils.CustomizeShutdown(&statefulSet.Spec.Template, instance)
+			lutils.CustomizeArtifacts(&statefulSet.Spec.Template, instance)
+			lutils.CustomizePauseReadinessGate(&statefulSet.Spec.Template, instance)
 			autils.CustomizePersistence(statefulSet, instance)
 			lutils.CustomizeLibertyEnv(&statefulSet.Spec.Template, instance)
 			lutils.ConfigureServiceability(&statefulSet.Spec.Template, instance)
+			lutils.ConfigureSessionCache(&statefulSet.Spec.Template, instance)
+			lutils.ConfigureSSO(&statefulSet.Spec.Template, instance, ssoSettings)
+			lutils.ConfigureLogging(&statefulSet.Spec.Template, instance)
+			lutils.ConfigureLTPA(&statefulSet.Spec.Template, instance, ltpaSecret)
 			if instance.Spec.CreateAppDefinition == nil || *instance.Spec.CreateAppDefinition {
 				m := make(map[string]string)
 				m["kappnav.subkind"] = "Liberty"
@@ -387,30 +856,35 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 			reqLogger.Error(err, "Failed to reconcile StatefulSet")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		lutils.SetApplicationReplicas(instance, statefulSet.Spec.Replicas, statefulSet.Status.ReadyReplicas)
+		lutils.SetStatefulSetStatus(instance, statefulSet)
+		refs = append(refs, lutils.GetResourceReference(statefulSet))
 
 	} else {
 		// Delete StatefulSet if exists
 		statefulSet := &appsv1.StatefulSet{ObjectMeta: defaultMeta}
-		err = r.DeleteResource(statefulSet)
+		err = rm.DeleteResource(statefulSet)
 		if err != nil {
 			reqLogger.Error(err, "Failed to delete Statefulset")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
 
-		// Delete StatefulSet if exists
-		headlesssvc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-headless", Namespace: instance.Namespace}}
-		err = r.DeleteResource(headlesssvc)
-
-		if err != nil {
-			reqLogger.Error(err, "Failed to delete headless Service")
-			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
-		}
 		deploy := &appsv1.Deployment{ObjectMeta: defaultMeta}
-		err = r.CreateOrUpdate(deploy, instance, func() error {
+		err = rm.CreateOrUpdate(deploy, instance, func() error {
 			autils.CustomizeDeployment(deploy, instance)
 			autils.CustomizePodSpec(&deploy.Spec.Template, instance)
+			lutils.CustomizeImageDigest(&deploy.Spec.Template, instance)
+			lutils.CustomizeSidecarContainers(&deploy.Spec.Template, instance)
+			lutils.CustomizeStartupProbe(&deploy.Spec.Template, instance)
+			lutils.CustomizeShutdown(&deploy.Spec.Template, instance)
+			lutils.CustomizeArtifacts(&deploy.Spec.Template, instance)
+			lutils.CustomizePauseReadinessGate(&deploy.Spec.Template, instance)
 			lutils.CustomizeLibertyEnv(&deploy.Spec.Template, instance)
 			lutils.ConfigureServiceability(&deploy.Spec.Template, instance)
+			lutils.ConfigureSessionCache(&deploy.Spec.Template, instance)
+			lutils.ConfigureSSO(&deploy.Spec.Template, instance, ssoSettings)
+			lutils.ConfigureLogging(&deploy.Spec.Template, instance)
+			lutils.ConfigureLTPA(&deploy.Spec.Template, instance, ltpaSecret)
 			if instance.Spec.CreateAppDefinition == nil || *instance.Spec.CreateAppDefinition {
 				m := make(map[string]string)
 				m["kappnav.subkind"] = "Liberty"
@@ -422,12 +896,15 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 			reqLogger.Error(err, "Failed to reconcile Deployment")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		lutils.SetApplicationReplicas(instance, deploy.Spec.Replicas, deploy.Status.ReadyReplicas)
+		lutils.SetDeploymentStatus(instance, deploy)
+		refs = append(refs, lutils.GetResourceReference(deploy))
 
 	}
 
 	if instance.Spec.Autoscaling != nil {
 		hpa := &autoscalingv1.HorizontalPodAutoscaler{ObjectMeta: defaultMeta}
-		err = r.CreateOrUpdate(hpa, instance, func() error {
+		err = rm.CreateOrUpdate(hpa, instance, func() error {
 			autils.CustomizeHPA(hpa, instance)
 			return nil
 		})
@@ -436,48 +913,23 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 			reqLogger.Error(err, "Failed to reconcile HorizontalPodAutoscaler")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
+		refs = append(refs, lutils.GetResourceReference(hpa))
 	} else {
 		hpa := &autoscalingv1.HorizontalPodAutoscaler{ObjectMeta: defaultMeta}
-		err = r.DeleteResource(hpa)
+		err = rm.DeleteResource(hpa)
 		if err != nil {
 			reqLogger.Error(err, "Failed to delete HorizontalPodAutoscaler")
 			return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 		}
 	}
 
-	if ok, err := r.IsGroupVersionSupported(routev1.SchemeGroupVersion.String()); err != nil {
-		reqLogger.Error(err, fmt.Sprintf("Failed to check if %s is supported", routev1.SchemeGroupVersion.String()))
-		r.ManageError(err, common.StatusConditionTypeReconciled, instance)
-	} else if ok {
-		if instance.Spec.Expose != nil && *instance.Spec.Expose {
-			route := &routev1.Route{ObjectMeta: defaultMeta}
-			err = r.CreateOrUpdate(route, instance, func() error {
-				autils.CustomizeRoute(route, instance)
-				return nil
-			})
-			if err != nil {
-				reqLogger.Error(err, "Failed to reconcile Route")
-				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
-			}
-		} else {
-			route := &routev1.Route{ObjectMeta: defaultMeta}
-			err = r.DeleteResource(route)
-			if err != nil {
-				reqLogger.Error(err, "Failed to delete Route")
-				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
-			}
-		}
-	} else {
-		reqLogger.V(1).Info(fmt.Sprintf("%s is not supported", routev1.SchemeGroupVersion.String()))
-	}
-
 	if ok, err := r.IsGroupVersionSupported(prometheusv1.SchemeGroupVersion.String()); err != nil {
 		reqLogger.Error(err, fmt.Sprintf("Failed to check if %s is supported", routev1.SchemeGroupVersion.String()))
 		r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 	} else if ok {
 		if instance.Spec.Monitoring != nil && (instance.Spec.CreateKnativeService == nil || !*instance.Spec.CreateKnativeService) {
 			sm := &prometheusv1.ServiceMonitor{ObjectMeta: defaultMeta}
-			err = r.CreateOrUpdate(sm, instance, func() error {
+			err = rm.CreateOrUpdate(sm, instance, func() error {
 				autils.CustomizeServiceMonitor(sm, instance)
 				return nil
 			})
@@ -485,9 +937,10 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 				reqLogger.Error(err, "Failed to reconcile ServiceMonitor")
 				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
 			}
+			refs = append(refs, lutils.GetResourceReference(sm))
 		} else {
 			sm := &prometheusv1.ServiceMonitor{ObjectMeta: defaultMeta}
-			err = r.DeleteResource(sm)
+			err = rm.DeleteResource(sm)
 			if err != nil {
 				reqLogger.Error(err, "Failed to delete ServiceMonitor")
 				return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
@@ -498,5 +951,303 @@ func (r *ReconcileOpenLiberty) Reconcile(request reconcile.Request) (reconcile.R
 		reqLogger.V(1).Info(fmt.Sprintf("%s is not supported", routev1.SchemeGroupVersion.String()))
 	}
 
-	return r.ManageSuccess(common.StatusConditionTypeReconciled, instance)
+	if err := r.watchLogs(instance, rm); err != nil {
+		reqLogger.Error(err, "Failed to watch the logs of the pods")
+		return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+	}
+
+	_, paused := rm.(*lutils.DriftRecorder)
+	delay, err := r.applyRuntimeLogging(instance, paused)
+	if err != nil {
+		reqLogger.Error(err, "Failed to apply the runtime logging overrides")
+		return r.ManageError(err, common.StatusConditionTypeReconciled, instance)
+	}
+	requeueAfter = nextRequeue(requeueAfter, delay)
+
+	r.setReconciledStatus(instance, refs, endpoints)
+	lutils.SetPausedStatus(instance, drift)
+	result, err = r.ManageSuccess(common.StatusConditionTypeReconciled, instance)
+	if err == nil && result == (reconcile.Result{}) && requeueAfter > 0 {
+		result.RequeueAfter = requeueAfter
+	}
+	return result, err
+}
+
+// getApplicationDefaults returns the defaults of the application from the highest to the lowest precedence,
+// along with the resources they come from
+func (r *ReconcileOpenLiberty) getApplicationDefaults(instance *openlibertyv1beta1.OpenLibertyApplication) ([]*openlibertyv1beta1.OpenLibertyDefaultsSpec, []string, error) {
+	defaults := []*openlibertyv1beta1.OpenLibertyDefaultsSpec{}
+	sources := []string{}
+
+	nsDefaults := &openlibertyv1beta1.OpenLibertyDefaults{}
+	err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: openlibertyv1beta1.OpenLibertyDefaultsName, Namespace: instance.Namespace}, nsDefaults)
+	if err == nil {
+		defaults = append(defaults, &nsDefaults.Spec)
+		sources = append(sources, "OpenLibertyDefaults/"+nsDefaults.Name)
+	} else if !errors.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	if isClusterWide() {
+		clusterDefaults := &openlibertyv1beta1.OpenLibertyClusterDefaults{}
+		err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: openlibertyv1beta1.OpenLibertyDefaultsName}, clusterDefaults)
+		if err == nil {
+			defaults = append(defaults, &clusterDefaults.Spec)
+			sources = append(sources, "OpenLibertyClusterDefaults/"+clusterDefaults.Name)
+		} else if !errors.IsNotFound(err) {
+			return nil, nil, err
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, nil, nil
+	}
+	return defaults, sources, nil
+}
+
+// getApplicationRequests returns the requests to reconcile the applications of the namespace, or of all namespaces
+func getApplicationRequests(cl client.Client, namespace string) []reconcile.Request {
+	apps := &openlibertyv1beta1.OpenLibertyApplicationList{}
+	if err := cl.List(context.TODO(), apps, client.InNamespace(namespace)); err != nil {
+		log.Error(err, "Failed to list the applications using the defaults", "namespace", namespace)
+		return nil
+	}
+	requests := []reconcile.Request{}
+	for _, app := range apps.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: app.Name, Namespace: app.Namespace}})
+	}
+	return requests
+}
+
+// setReconciledStatus records the resources and URLs of the application once all its resources are reconciled
+func (r *ReconcileOpenLiberty) setReconciledStatus(instance *openlibertyv1beta1.OpenLibertyApplication,
+	refs []openlibertyv1beta1.OpenLibertyApplicationResourceReference, endpoints []openlibertyv1beta1.OpenLibertyApplicationEndpoint) {
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.References = refs
+	// External URLs are listed before the address of the Service
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		return endpoints[i].Type != openlibertyv1beta1.OpenLibertyApplicationEndpointTypeService &&
+			endpoints[j].Type == openlibertyv1beta1.OpenLibertyApplicationEndpointTypeService
+	})
+	instance.Status.Endpoints = endpoints
+}
+
+// watchLogs follows the logs of the pods of the application and reports the errors they logged and their inventories,
+// remediates the pods that triggered a rule, and reports their FFDC incidents in the OpenLibertyIncidents of the
+// application
+func (r *ReconcileOpenLiberty) watchLogs(instance *openlibertyv1beta1.OpenLibertyApplication, rm resourceManager) error {
+	if r.logWatcher == nil {
+		return nil
+	}
+	pods := &corev1.PodList{}
+	if lutils.IsLogWatcherEnabled(instance) {
+		err := r.GetClient().List(context.TODO(), pods, client.InNamespace(instance.Namespace),
+			client.MatchingLabels{"app.kubernetes.io/instance": instance.Name})
+		if err != nil {
+			return err
+		}
+	}
+	r.logWatcher.sync(instance, pods.Items)
+	if err := r.logWatcher.copyFFDCFiles(instance); err != nil {
+		// The files are copied again on the next reconcile
+		log.Error(err, "Failed to copy the incident files", "Request.Namespace", instance.Namespace, "Request.Name", instance.Name)
+	}
+	report := r.logWatcher.report(instance)
+	lutils.SetApplicationErrorsStatus(instance, report.errors, report.failures)
+	mismatch := lutils.GetInventoryMismatch(instance.Status.Inventory)
+	lutils.SetInventoryStatus(instance, report.inventory)
+	if m := lutils.GetInventoryMismatch(instance.Status.Inventory); m != "" && m != mismatch {
+		r.GetRecorder().Event(instance, corev1.EventTypeWarning, "InventoryMismatch", m)
+	}
+	_, paused := rm.(*lutils.DriftRecorder)
+	if err := r.remediate(instance, report.messages, paused); err != nil {
+		return err
+	}
+
+	incidents := &openlibertyv1beta1.OpenLibertyIncidents{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	if !lutils.IsFFDCEnabled(instance) {
+		return rm.DeleteResource(incidents)
+	}
+	err := rm.CreateOrUpdate(incidents, instance, func() error {
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// The OpenLibertyIncidents isn't created nor updated while the application is paused
+	if paused {
+		return nil
+	}
+	status := incidents.DeepCopy()
+	lutils.SetIncidentsStatus(status, report.incidents)
+	if equality.Semantic.DeepEqual(incidents.Status, status.Status) {
+		return nil
+	}
+	return r.GetClient().Status().Update(context.TODO(), status)
+}
+
+// isWorkloadStatusChanged returns true when the status of a Deployment, StatefulSet or Knative Service changed
+func isWorkloadStatusChanged(old, new runtime.Object) bool {
+	switch o := old.(type) {
+	case *appsv1.Deployment:
+		if n, ok := new.(*appsv1.Deployment); ok {
+			return !reflect.DeepEqual(o.Status, n.Status)
+		}
+	case *appsv1.StatefulSet:
+		if n, ok := new.(*appsv1.StatefulSet); ok {
+			return !reflect.DeepEqual(o.Status, n.Status)
+		}
+	case *servingv1alpha1.Service:
+		if n, ok := new.(*servingv1alpha1.Service); ok {
+			return !reflect.DeepEqual(o.Status, n.Status)
+		}
+	}
+	return false
+}
+
+// getIngressRequests returns the requests of the applications whose Service an Ingress routes to
+func getIngressRequests(obj runtime.Object) []reconcile.Request {
+	ing, ok := obj.(*networkingv1beta1.Ingress)
+	if !ok {
+		return nil
+	}
+	names := map[string]bool{}
+	if ing.Spec.Backend != nil {
+		names[ing.Spec.Backend.ServiceName] = true
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			names[path.Backend.ServiceName] = true
+		}
+	}
+	requests := []reconcile.Request{}
+	for name := range names {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ing.Namespace, Name: name}})
+	}
+	return requests
+}
+
+// isClusterWide returns true when the operator watches all namespaces
+func isClusterWide() bool {
+	watchNamespaces, err := lutils.GetWatchNamespaces()
+	return err == nil && len(watchNamespaces) == 1 && watchNamespaces[0] == ""
+}
+
+// nextRequeue returns the shortest of the positive delays
+func nextRequeue(current, delay time.Duration) time.Duration {
+	if delay > 0 && (current == 0 || delay < current) {
+		return delay
+	}
+	return current
+}
+
+// resolveImageDigest resolves the tag of the application image to a digest and records it in the status
+func (r *ReconcileOpenLiberty) resolveImageDigest(instance *openlibertyv1beta1.OpenLibertyApplication) error {
+	var pullSecret *corev1.Secret
+	if instance.Spec.PullSecret != nil && *instance.Spec.PullSecret != "" {
+		pullSecret = &corev1.Secret{}
+		err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: *instance.Spec.PullSecret, Namespace: instance.Namespace}, pullSecret)
+		if err != nil {
+			return err
+		}
+	}
+
+	digest, err := r.imageResolver.Resolve(instance.Spec.ApplicationImage, pullSecret)
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	instance.Status.ImageReference = instance.Spec.ApplicationImage
+	instance.Status.ResolvedImage = lutils.GetImageWithDigest(instance.Spec.ApplicationImage, digest)
+	instance.Status.ImageLastResolved = &now
+	return nil
+}
+
+// reconcileLTPAKeys generates the LTPA keys shared by the pods of the application and rotates them on schedule.
+// It returns the Secret holding the keys and the delay before the keys need to be reconciled again.
+func (r *ReconcileOpenLiberty) reconcileLTPAKeys(instance *openlibertyv1beta1.OpenLibertyApplication, rm resourceManager) (*corev1.Secret, time.Duration, error) {
+	// Keys shared across the namespace outlive any single application
+	var owner metav1.Object = instance
+	if instance.Spec.LTPA.GetScope() == openlibertyv1beta1.OpenLibertyApplicationLTPAScopeNamespace {
+		owner = nil
+	}
+
+	now := time.Now()
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetLTPASecretName(instance), Namespace: instance.Namespace}}
+	err := rm.CreateOrUpdate(secret, owner, func() error {
+		return lutils.CustomizeLTPASecret(secret, instance, now)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	// The keys are neither generated nor rotated while the application is paused
+	if _, paused := rm.(*lutils.DriftRecorder); paused {
+		return secret, 0, nil
+	}
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: lutils.GetLTPAJobName(instance), Namespace: instance.Namespace}}
+	if !lutils.IsLTPAKeygenRequired(secret) {
+		return secret, lutils.GetLTPARotationDelay(secret, instance, now), nil
+	}
+
+	err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, job)
+	if err != nil && errors.IsNotFound(err) {
+		err = rm.CreateOrUpdate(job, owner, func() error {
+			lutils.CustomizeLTPAJob(job, instance)
+			return nil
+		})
+		return secret, 5 * time.Second, err
+	} else if err != nil {
+		return nil, 0, err
+	}
+
+	pods := &corev1.PodList{}
+	err = r.GetClient().List(context.TODO(), pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name})
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if !lutils.IsLTPAKeygenPodRunning(pod) {
+			continue
+		}
+		container, cmd := lutils.GetLTPAKeysCommand()
+		keys, err := executeCommandInContainer(r.restConfig, pod.Name, pod.Namespace, container, cmd)
+		if err != nil || keys == "" {
+			// The keys may still be being generated
+			log.V(1).Info("LTPA keys not generated yet", "pod", pod.Name)
+			continue
+		}
+		lutils.RotateLTPAKeys(secret, []byte(keys), now)
+		if err = r.GetClient().Update(context.TODO(), secret); err != nil {
+			return nil, 0, err
+		}
+		err = r.GetClient().Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, 0, err
+		}
+		return secret, lutils.GetLTPARotationDelay(secret, instance, now), nil
+	}
+
+	if job.Status.Succeeded > 0 {
+		// The keys weren't read before the container stopped waiting, so start over
+		err = r.GetClient().Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, 0, err
+		}
+		return secret, 5 * time.Second, nil
+	}
+	if job.Spec.BackoffLimit != nil && job.Status.Failed > *job.Spec.BackoffLimit {
+		// Start over on the next reconcile
+		err = r.GetClient().Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, 0, err
+		}
+		return nil, 0, fmt.Errorf("Job %s failed to generate the LTPA keys", job.Name)
+	}
+	return secret, 5 * time.Second, nil
 }
@@ -3,29 +3,39 @@ package openliberty
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"strconv"
+	"strings"
 
 	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	lutils "github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	"github.com/appsody/appsody-operator/pkg/common"
 	autils "github.com/appsody/appsody-operator/pkg/utils"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/discovery"
 	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	coretesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
@@ -75,12 +85,14 @@ func TestOpenLibertyController(t *testing.T) {
 		t.Fatalf("Unable to add route scheme: (%v)", err)
 	}
 
-	s.AddKnownTypes(openlibertyv1beta1.SchemeGroupVersion, openliberty)
+	s.AddKnownTypes(openlibertyv1beta1.SchemeGroupVersion, openliberty, &openlibertyv1beta1.OpenLibertyApplicationList{},
+		&openlibertyv1beta1.OpenLibertyDefaults{}, &openlibertyv1beta1.OpenLibertyClusterDefaults{},
+		&openlibertyv1beta1.OpenLibertyIncidents{}, &openlibertyv1beta1.OpenLibertyDump{})
 
 	// Create a fake client to mock API calls.
 	cl := fakeclient.NewFakeClient(objs...)
 
-	rb := autils.NewReconcilerBase(cl, s, &rest.Config{}, record.NewFakeRecorder(10))
+	rb := autils.NewReconcilerBase(cl, s, &rest.Config{}, record.NewFakeRecorder(100))
 
 	// Create a ReconcileAppsodyApplication object
 	r := &ReconcileOpenLiberty{ReconcilerBase: rb}
@@ -113,6 +125,46 @@ func TestOpenLibertyController(t *testing.T) {
 	if err := testServiceAccount(t, r, rb); err != nil {
 		t.Fatalf("%v", err)
 	}
+
+	if err := testSessionCache(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testSSO(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testLTPA(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testImageDigest(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testSidecarContainers(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testDefaults(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testStatus(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testPaused(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testLogWatcher(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	if err := testRemediation(t, r, rb); err != nil {
+		t.Fatalf("%v", err)
+	}
 }
 
 // Test methods
@@ -307,63 +359,1117 @@ func testServiceAccount(t *testing.T, r *ReconcileOpenLiberty, rb autils.Reconci
 	}
 	updateOpenLiberty(r, openliberty, t)
 
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
 	// check that the default service account was deleted
 	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, serviceaccount); err == nil {
+		return fmt.Errorf("default service account was not deleted")
+	}
+
+	// The configured service account gets the redirect annotation when it is the OpenShift OAuth client
+	custom := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: namespace}}
+	if err = r.GetClient().Create(context.TODO(), custom); err != nil {
 		return err
 	}
-	return nil
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage:   appImage,
+		ServiceAccountName: &serviceAccountName,
+		Expose:             &expose,
+		SSO:                &openlibertyv1beta1.OpenLibertyApplicationSSO{OpenShift: &openlibertyv1beta1.OpenLibertyApplicationSSOOpenShift{}},
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	// The OpenShift OAuth server can't be discovered here, so only the annotation is checked
+	r.Reconcile(req)
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: serviceAccountName, Namespace: namespace}, custom); err != nil {
+		return err
+	}
+	redirect := custom.Annotations[lutils.SSOServiceAccountRedirectAnnotation]
+
+	openliberty.Spec.SSO = nil
+	updateOpenLiberty(r, openliberty, t)
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+	custom = &corev1.ServiceAccount{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: serviceAccountName, Namespace: namespace}, custom); err != nil {
+		return err
+	}
+	_, redirectFound := custom.Annotations[lutils.SSOServiceAccountRedirectAnnotation]
+
+	tests := []Test{
+		{"configured service account redirect", lutils.GetSSOServiceAccountRedirectReference(openliberty), redirect},
+		{"configured service account redirect removed", false, redirectFound},
+		{"configured service account not owned", 0, len(custom.OwnerReferences)},
+	}
+	return verifyTests(tests)
 }
 
-// most of this functionality is handled by autils, only verifying liberty logic
-func testServiceMonitoring(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+func testSessionCache(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
 	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
 	openliberty := createOpenLibertyApp(name, namespace, spec)
 	req := createReconcileRequest(name, namespace)
 
-	// Test with monitoring specified
-	openliberty.Spec.Monitoring = &openlibertyv1beta1.OpenLibertyApplicationMonitoring{}
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		SessionCache: &openlibertyv1beta1.OpenLibertyApplicationSessionCache{
+			LibraryImage: "hazelcast-lib",
+		},
+	}
 	updateOpenLiberty(r, openliberty, t)
+
 	res, err := r.Reconcile(req)
 	if err = verifyReconcile(res, err); err != nil {
 		return err
 	}
 
-	svc := &corev1.Service{ObjectMeta: defaultMeta}
-	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, svc); err != nil {
+	cm := &corev1.ConfigMap{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-sessioncache", Namespace: namespace}, cm); err != nil {
+		return fmt.Errorf("Get session cache ConfigMap (%v)", err)
+	}
+
+	// Embedded members are discovered through the headless service
+	headless := &corev1.Service{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: statefulSetSN, Namespace: namespace}, headless); err != nil {
+		return fmt.Errorf("Get headless Service (%v)", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+
+	mountPaths := []string{}
+	for _, vm := range dep.Spec.Template.Spec.Containers[0].VolumeMounts {
+		mountPaths = append(mountPaths, vm.MountPath)
+	}
+
+	tests := []Test{
+		{"hazelcast config", true, cm.Data["hazelcast.xml"] != ""},
+		{"session cache dropin", true, cm.Data["sessioncache.xml"] != ""},
+		{"publish not ready addresses", true, headless.Spec.PublishNotReadyAddresses},
+		{"session cache mounts", []string{"/config/sessioncache", "/config/configDropins/overrides/sessioncache.xml", "/opt/ol/sessioncache/lib"}, mountPaths},
+		{"library init container", "hazelcast-lib", dep.Spec.Template.Spec.InitContainers[0].Image},
+	}
+	if err = verifyTests(tests); err != nil {
 		return err
 	}
 
-	monitorTests := []Test{
-		{"Monitor label assigned", "true", svc.Labels["app."+openliberty.GetGroupName()+"/monitor"]},
+	// Disabling the session cache removes its resources
+	openliberty.Spec.SessionCache = nil
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
 	}
-	if err = verifyTests(monitorTests); err != nil {
+
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-sessioncache", Namespace: namespace}, cm); err == nil {
+		return fmt.Errorf("session cache ConfigMap was not deleted")
+	}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: statefulSetSN, Namespace: namespace}, headless); err == nil {
+		return fmt.Errorf("headless Service was not deleted")
+	}
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	if len(dep.Spec.Template.Spec.InitContainers) != 0 {
+		return fmt.Errorf("session cache library init container was not removed")
+	}
+
+	return nil
+}
+
+func testSSO(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		Expose:           &expose,
+		SSO: &openlibertyv1beta1.OpenLibertyApplicationSSO{
+			GitHub: &openlibertyv1beta1.OpenLibertyApplicationSSOProvider{
+				ClientID:     corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "github"}, Key: "clientId"},
+				ClientSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "github"}, Key: "clientSecret"},
+			},
+			RedirectToRPHostAndPort: "https://app.example.com",
+		},
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
 		return err
 	}
 
-	// Test without monitoring on
-	openliberty.Spec.Monitoring = nil
+	cm := &corev1.ConfigMap{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-sso", Namespace: namespace}, cm); err != nil {
+		return fmt.Errorf("Get single sign-on ConfigMap (%v)", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+
+	envNames := []string{}
+	for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+		envNames = append(envNames, env.Name)
+	}
+
+	tests := []Test{
+		{"github login", `  <githubLogin id="github" clientId="${env.SSO_GITHUB_CLIENT_ID}" clientSecret="${env.SSO_GITHUB_CLIENT_SECRET}" redirectToRPHostAndPort="https://app.example.com"/>`, strings.Split(cm.Data["sso.xml"], "\n")[5]},
+		{"client credentials", []string{"SSO_GITHUB_CLIENT_ID", "SSO_GITHUB_CLIENT_SECRET"}, envNames[len(envNames)-2:]},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// Disabling single sign-on removes its configuration
+	openliberty.Spec.SSO = nil
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-sso", Namespace: namespace}, cm); err == nil {
+		return fmt.Errorf("single sign-on ConfigMap was not deleted")
+	}
+
+	return nil
+}
+
+func testLTPA(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		LTPA:             &openlibertyv1beta1.OpenLibertyApplicationLTPA{},
+	}
 	updateOpenLiberty(r, openliberty, t)
+
+	// The keys are generated by a Job, so the reconcile checks back on it
+	res, err := r.Reconcile(req)
+	if err != nil {
+		return fmt.Errorf("reconcile: (%v)", err)
+	}
+
+	job := &batchv1.Job{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-app-ltpa-keygen", Namespace: namespace}, job); err != nil {
+		return fmt.Errorf("Get LTPA keygen Job (%v)", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-app-ltpa", Namespace: namespace}, secret); err != nil {
+		return fmt.Errorf("Get LTPA Secret (%v)", err)
+	}
+
+	tests := []Test{
+		{"requeue while keys are generated", true, res.RequeueAfter > 0},
+		{"keygen image", appImage, job.Spec.Template.Spec.Containers[0].Image},
+		{"keygen password", "nextPassword", job.Spec.Template.Spec.Containers[0].Env[0].ValueFrom.SecretKeyRef.Key},
+		{"password generated", true, len(secret.Data["nextPassword"]) > 0},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// Simulate the pod of the Job waiting for the keys to be read
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-abcde", Namespace: namespace, Labels: map[string]string{"job-name": job.Name}},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "ltpa-keygen",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+	if err = r.GetClient().Create(context.TODO(), pod); err != nil {
+		return fmt.Errorf("Create keygen pod (%v)", err)
+	}
+	executed := ""
+	executeCommandInContainer = func(config *rest.Config, podName, podNamespace, containerName string, command []string) (string, error) {
+		executed = podName + "/" + containerName
+		return "com.ibm.websphere.ltpa.version=1.0", nil
+	}
+	defer func() {
+		executeCommandInContainer = lutils.ExecuteCommandInContainer
+	}()
+
 	res, err = r.Reconcile(req)
 	if err = verifyReconcile(res, err); err != nil {
 		return err
 	}
 
-	svc = &corev1.Service{ObjectMeta: defaultMeta}
-	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, svc); err != nil {
+	secret = &corev1.Secret{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-app-ltpa", Namespace: namespace}, secret); err != nil {
+		return fmt.Errorf("Get LTPA Secret (%v)", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+
+	tests = []Test{
+		{"keys read", pod.Name + "/ltpa-keygen", executed},
+		{"keys stored", "com.ibm.websphere.ltpa.version=1.0", string(secret.Data["ltpa.keys"])},
+		{"dropin rendered", true, strings.Contains(string(secret.Data["ltpa.xml"]), `keysFileName="/config/managedLTPA/ltpa.keys"`)},
+		{"keys mounted", name + "-app-ltpa", dep.Spec.Template.Spec.Volumes[len(dep.Spec.Template.Spec.Volumes)-1].Secret.SecretName},
+		{"pods rolled", true, dep.Spec.Template.Annotations["openliberty.io/ltpa-keys-hash"] != ""},
+	}
+	if err = verifyTests(tests); err != nil {
 		return err
 	}
 
-	monitorTests = []Test{
-		{"Monitor label unassigned", "", svc.Labels["app."+openliberty.GetGroupName()+"/monitor"]},
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: job.Name, Namespace: namespace}, &batchv1.Job{}); err == nil {
+		return fmt.Errorf("LTPA keygen Job was not deleted")
 	}
-	if err = verifyTests(monitorTests); err != nil {
+
+	// Sharing the keys across the namespace removes the Secret of the application
+	openliberty.Spec.LTPA = &openlibertyv1beta1.OpenLibertyApplicationLTPA{Scope: openlibertyv1beta1.OpenLibertyApplicationLTPAScopeNamespace}
+	updateOpenLiberty(r, openliberty, t)
+
+	if _, err = r.Reconcile(req); err != nil {
+		return fmt.Errorf("reconcile: (%v)", err)
+	}
+
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: name + "-app-ltpa", Namespace: namespace}, secret); err == nil {
+		return fmt.Errorf("LTPA Secret of the application was not deleted")
+	}
+	nsSecret := &corev1.Secret{}
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: "openliberty-namespace-ltpa", Namespace: namespace}, nsSecret); err != nil {
+		return fmt.Errorf("Get namespace LTPA Secret (%v)", err)
+	}
+	if len(nsSecret.OwnerReferences) != 0 {
+		return fmt.Errorf("namespace LTPA Secret is owned by the application")
+	}
+	if err = r.GetClient().Delete(context.TODO(), &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "openliberty-namespace-ltpa-keygen", Namespace: namespace}}); err != nil {
+		return fmt.Errorf("Delete namespace LTPA keygen Job (%v)", err)
+	}
+
+	// Disabling LTPA keys keeps the namespace Secret
+	openliberty.Spec.LTPA = nil
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
 		return err
 	}
 
+	if err = r.GetClient().Get(context.TODO(), types.NamespacedName{Name: "openliberty-namespace-ltpa", Namespace: namespace}, nsSecret); err != nil {
+		return fmt.Errorf("namespace LTPA Secret was deleted (%v)", err)
+	}
+
 	return nil
 }
 
-// Helper Functions
+func testImageDigest(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	// Registry stand-in serving a tag that can be moved
+	digest := "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	unavailable := false
+	registry := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if unavailable {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", digest)
+	}))
+	defer registry.Close()
+	r.imageResolver.Client = registry.Client()
+	image := strings.TrimPrefix(registry.URL, "https://") + "/app:latest"
+
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: image,
+		ImageDigest:      &openlibertyv1beta1.OpenLibertyApplicationImageDigest{PollInterval: "10m"},
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err := r.Reconcile(req)
+	if err != nil {
+		return fmt.Errorf("reconcile: (%v)", err)
+	}
+
+	dep := &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+
+	pinned := strings.TrimSuffix(image, ":latest") + "@" + digest
+	tests := []Test{
+		{"pinned image", pinned, dep.Spec.Template.Spec.Containers[0].Image},
+		{"resolved image", pinned, openliberty.Status.ResolvedImage},
+		{"image reference", image, openliberty.Status.ImageReference},
+		{"image resolved", corev1.ConditionTrue, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeImageResolved).GetStatus()},
+		{"poll interval", true, res.RequeueAfter > 9*time.Minute && res.RequeueAfter <= 10*time.Minute},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// Move the tag and let the poll interval elapse
+	digest = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	lastResolved := metav1.NewTime(time.Now().Add(-time.Hour))
+	openliberty.Status.ImageLastResolved = &lastResolved
+	updateOpenLiberty(r, openliberty, t)
+
+	if _, err = r.Reconcile(req); err != nil {
+		return fmt.Errorf("reconcile: (%v)", err)
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	pinned = strings.TrimSuffix(image, ":latest") + "@" + digest
+	if err = verifyTests([]Test{{"rolled out moved tag", pinned, dep.Spec.Template.Spec.Containers[0].Image}}); err != nil {
+		return err
+	}
+
+	// The resolved image is kept while the registry is unavailable
+	unavailable = true
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	lastResolved = metav1.NewTime(time.Now().Add(-time.Hour))
+	openliberty.Status.ImageLastResolved = &lastResolved
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err != nil {
+		return fmt.Errorf("reconcile: (%v)", err)
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	resolved := openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeImageResolved)
+	tests = []Test{
+		{"image kept", pinned, dep.Spec.Template.Spec.Containers[0].Image},
+		{"resolution failed", corev1.ConditionFalse, resolved.GetStatus()},
+		{"resolution failure reason", "ResolutionFailed", resolved.GetReason()},
+		{"retried", time.Minute, res.RequeueAfter},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+	unavailable = false
+
+	// Disabling digest resolution deploys the tag as is
+	openliberty.Spec.ImageDigest = nil
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	return verifyTests([]Test{
+		{"unpinned image", image, dep.Spec.Template.Spec.Containers[0].Image},
+		{"image resolved removed", true, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeImageResolved) == nil},
+	})
+}
+
+func testSidecarContainers(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage:  appImage,
+		Storage:           &storage,
+		SidecarContainers: []corev1.Container{{Name: "proxy", Image: "envoy"}},
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	statefulSet := &appsv1.StatefulSet{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, statefulSet); err != nil {
+		return fmt.Errorf("Get StatefulSet (%v)", err)
+	}
+
+	containers := statefulSet.Spec.Template.Spec.Containers
+	tests := []Test{
+		{"containers", 2, len(containers)},
+		{"application container", "app", containers[0].Name},
+		{"sidecar container", "envoy", containers[1].Image},
+		{"storage mounted in application container", "/mnt/data", containers[0].VolumeMounts[len(containers[0].VolumeMounts)-1].MountPath},
+	}
+	return verifyTests(tests)
+}
+
+func testDefaults(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	pullSecret := "registry-credentials"
+	defaults := &openlibertyv1beta1.OpenLibertyDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+		Spec: openlibertyv1beta1.OpenLibertyDefaultsSpec{
+			PullPolicy: &pullPolicy,
+			PullSecret: &pullSecret,
+			Env:        []corev1.EnvVar{{Name: "TZ", Value: "UTC"}, {Name: "WLP_LOGGING_CONSOLE_FORMAT", Value: "basic"}},
+		},
+	}
+	if err := r.GetClient().Create(context.TODO(), defaults); err != nil {
+		return fmt.Errorf("Create OpenLibertyDefaults (%v)", err)
+	}
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		Env:              []corev1.EnvVar{{Name: "WLP_LOGGING_CONSOLE_FORMAT", Value: "json"}},
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	dep := &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+
+	sa := &corev1.ServiceAccount{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, sa); err != nil {
+		return fmt.Errorf("Get ServiceAccount (%v)", err)
+	}
+
+	env := dep.Spec.Template.Spec.Containers[0].Env
+	tests := []Test{
+		{"pull secret from defaults", pullSecret, sa.ImagePullSecrets[0].Name},
+		{"pull policy from defaults", pullPolicy, dep.Spec.Template.Spec.Containers[0].ImagePullPolicy},
+		{"application env takes precedence", corev1.EnvVar{Name: "WLP_LOGGING_CONSOLE_FORMAT", Value: "json"}, env[0]},
+		{"env from defaults", corev1.EnvVar{Name: "TZ", Value: "UTC"}, env[1]},
+		{"applied env", []corev1.EnvVar{{Name: "TZ", Value: "UTC"}}, openliberty.Status.AppliedDefaults.Env},
+		{"defaults sources", []string{"OpenLibertyDefaults/default"}, openliberty.Status.DefaultsSources},
+		{"applications using the defaults", []reconcile.Request{req}, getApplicationRequests(r.GetClient(), namespace)},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// Removing the defaults removes their values
+	if err = r.GetClient().Delete(context.TODO(), defaults); err != nil {
+		return fmt.Errorf("Delete OpenLibertyDefaults (%v)", err)
+	}
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{ApplicationImage: appImage}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+
+	tests = []Test{
+		{"only the Liberty logging env", 3, len(dep.Spec.Template.Spec.Containers[0].Env)},
+		{"default pull policy", corev1.PullIfNotPresent, dep.Spec.Template.Spec.Containers[0].ImagePullPolicy},
+		{"no applied defaults", true, openliberty.Status.AppliedDefaults == nil},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// The default pull policy isn't saved, so that a pull policy set later in the defaults reaches the application.
+	// The status is updated through the status subresource, which doesn't change the spec.
+	base := r.ReconcilerBase
+	defer func() { r.ReconcilerBase = base }()
+	r.ReconcilerBase = autils.NewReconcilerBase(statusSubresourceClient{base.GetClient()}, scheme.Scheme, &rest.Config{}, base.GetRecorder())
+	r.SetDiscoveryClient(createFakeDiscoveryClient())
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{ApplicationImage: appImage}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	if err = verifyTests([]Test{{"default pull policy not saved", true, openliberty.Spec.PullPolicy == nil}}); err != nil {
+		return err
+	}
+
+	defaults = &openlibertyv1beta1.OpenLibertyDefaults{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+		Spec:       openlibertyv1beta1.OpenLibertyDefaultsSpec{PullPolicy: &pullPolicy},
+	}
+	if err = r.GetClient().Create(context.TODO(), defaults); err != nil {
+		return fmt.Errorf("Create OpenLibertyDefaults (%v)", err)
+	}
+	defer r.GetClient().Delete(context.TODO(), defaults)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	return verifyTests([]Test{{"pull policy from later defaults", pullPolicy, dep.Spec.Template.Spec.Containers[0].ImagePullPolicy}})
+}
+
+// most of this functionality is handled by autils, only verifying liberty logic
+func testServiceMonitoring(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	// Test with monitoring specified
+	openliberty.Spec.Monitoring = &openlibertyv1beta1.OpenLibertyApplicationMonitoring{}
+	updateOpenLiberty(r, openliberty, t)
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	svc := &corev1.Service{ObjectMeta: defaultMeta}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, svc); err != nil {
+		return err
+	}
+
+	monitorTests := []Test{
+		{"Monitor label assigned", "true", svc.Labels["app."+openliberty.GetGroupName()+"/monitor"]},
+	}
+	if err = verifyTests(monitorTests); err != nil {
+		return err
+	}
+
+	// Test without monitoring on
+	openliberty.Spec.Monitoring = nil
+	updateOpenLiberty(r, openliberty, t)
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	svc = &corev1.Service{ObjectMeta: defaultMeta}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, svc); err != nil {
+		return err
+	}
+
+	monitorTests = []Test{
+		{"Monitor label unassigned", "", svc.Labels["app."+openliberty.GetGroupName()+"/monitor"]},
+	}
+	if err = verifyTests(monitorTests); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func testStatus(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	req := createReconcileRequest(name, namespace)
+
+	ingress := &networkingv1beta1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: namespace},
+		Spec: networkingv1beta1.IngressSpec{
+			TLS: []networkingv1beta1.IngressTLS{{Hosts: []string{"app.example.com"}}},
+			Rules: []networkingv1beta1.IngressRule{{
+				Host: "app.example.com",
+				IngressRuleValue: networkingv1beta1.IngressRuleValue{HTTP: &networkingv1beta1.HTTPIngressRuleValue{
+					Paths: []networkingv1beta1.HTTPIngressPath{{Path: "/", Backend: networkingv1beta1.IngressBackend{ServiceName: name}}},
+				}},
+			}},
+		},
+	}
+	if err := r.GetClient().Create(context.TODO(), ingress); err != nil {
+		return fmt.Errorf("Create Ingress (%v)", err)
+	}
+
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		Replicas:         &replicas,
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	refs := map[string]bool{}
+	for _, ref := range openliberty.Status.References {
+		refs[ref.APIVersion+"/"+ref.Kind+"/"+ref.Name] = true
+	}
+
+	tests := []Test{
+		{"not ready", corev1.ConditionFalse, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetStatus()},
+		{"progressing", corev1.ConditionTrue, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeProgressing).GetStatus()},
+		{"replicas", replicas, openliberty.Status.Replicas},
+		{"ready replicas", int32(0), openliberty.Status.ReadyReplicas},
+		{"observed generation", openliberty.Generation, openliberty.Status.ObservedGeneration},
+		{"no resolved image without digest", "", openliberty.Status.ResolvedImage},
+		{"endpoints", []openlibertyv1beta1.OpenLibertyApplicationEndpoint{
+			{Type: openlibertyv1beta1.OpenLibertyApplicationEndpointTypeIngress, URI: "https://app.example.com"},
+			{Type: openlibertyv1beta1.OpenLibertyApplicationEndpointTypeService, URI: name + "." + namespace + ".svc:9080"},
+		}, openliberty.Status.Endpoints},
+		{"Deployment reference", true, refs["apps/v1/Deployment/"+name]},
+		{"Service reference", true, refs["v1/Service/"+name]},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// The application is ready once the rollout of the Deployment completes
+	dep := &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	dep.Status = appsv1.DeploymentStatus{
+		ObservedGeneration: dep.Generation,
+		Replicas:           replicas,
+		UpdatedReplicas:    replicas,
+		ReadyReplicas:      replicas,
+	}
+	if err = r.GetClient().Update(context.TODO(), dep); err != nil {
+		return fmt.Errorf("Update Deployment (%v)", err)
+	}
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	tests = []Test{
+		{"ready", corev1.ConditionTrue, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetStatus()},
+		{"rollout complete", corev1.ConditionFalse, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeProgressing).GetStatus()},
+		{"ready replicas after rollout", replicas, openliberty.Status.ReadyReplicas},
+	}
+	return verifyTests(tests)
+}
+
+func testPaused(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	req := createReconcileRequest(name, namespace)
+	openliberty := &openlibertyv1beta1.OpenLibertyApplication{}
+	if err := r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		Replicas:         &replicas,
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/health/ready", Port: intstr.FromInt(9080)}},
+		},
+		Volumes: []corev1.Volume{{Name: "config", VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "config"}},
+		}}},
+	}
+	updateOpenLiberty(r, openliberty, t)
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	// The values defaulted by the API server are not reported as drift
+	dep := &appsv1.Deployment{}
+	if err := r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	deadline, history, mode := int32(600), int32(10), int32(420)
+	dep.Spec.ProgressDeadlineSeconds, dep.Spec.RevisionHistoryLimit = &deadline, &history
+	dep.Spec.Template.Spec.DNSPolicy = corev1.DNSClusterFirst
+	for i := range dep.Spec.Template.Spec.Volumes {
+		if dep.Spec.Template.Spec.Volumes[i].Secret != nil {
+			dep.Spec.Template.Spec.Volumes[i].Secret.DefaultMode = &mode
+		}
+		if dep.Spec.Template.Spec.Volumes[i].ConfigMap != nil {
+			dep.Spec.Template.Spec.Volumes[i].ConfigMap.DefaultMode = &mode
+		}
+	}
+	container := &dep.Spec.Template.Spec.Containers[0]
+	container.TerminationMessagePath = "/dev/termination-log"
+	for _, probe := range []*corev1.Probe{container.LivenessProbe, container.ReadinessProbe} {
+		if probe != nil {
+			probe.SuccessThreshold, probe.TimeoutSeconds = 1, 1
+			if probe.HTTPGet != nil {
+				probe.HTTPGet.Scheme = corev1.URISchemeHTTP
+			}
+		}
+	}
+	if err := r.GetClient().Update(context.TODO(), dep); err != nil {
+		return fmt.Errorf("Update Deployment (%v)", err)
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	pause := true
+	openliberty.Spec.Paused = &pause
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	if c := openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypePaused); c.GetReason() != "NoDrift" {
+		return fmt.Errorf("defaulted Deployment reported as drift: %s", c.GetMessage())
+	}
+
+	// Edit the Deployment by hand while the application is paused
+	dep = &appsv1.Deployment{}
+	if err := r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	one := int32(1)
+	dep.Spec.Replicas = &one
+	dep.Spec.Template.Spec.Containers[0].Image = "hotfix"
+	if err := r.GetClient().Update(context.TODO(), dep); err != nil {
+		return fmt.Errorf("Update Deployment (%v)", err)
+	}
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	paused := openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypePaused)
+	tests := []Test{
+		{"replicas kept", one, *dep.Spec.Replicas},
+		{"image kept", "hotfix", dep.Spec.Template.Spec.Containers[0].Image},
+		{"paused", corev1.ConditionTrue, paused.GetStatus()},
+		{"drift reason", "Drift", paused.GetReason()},
+		{"drift message", true, strings.Contains(paused.GetMessage(),
+			"Deployment/"+name+" differs in spec.replicas, spec.template.spec.containers")},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// The Deployment is reverted once the application is resumed
+	openliberty.Spec.Paused = nil
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	dep = &appsv1.Deployment{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, dep); err != nil {
+		return fmt.Errorf("Get Deployment (%v)", err)
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	tests = []Test{
+		{"replicas reverted", replicas, *dep.Spec.Replicas},
+		{"image reverted", appImage, dep.Spec.Template.Spec.Containers[0].Image},
+		{"resumed", true, openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypePaused) == nil},
+	}
+	return verifyTests(tests)
+}
+
+func testLogWatcher(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	// The API server serves the logs of the first pod and doesn't allow reading the logs of the second one
+	logs := `{"type":"liberty_message","ibm_datetime":"2020-03-02T10:15:30.123+0000","ibm_messageId":"CWWKZ0001I","message":"CWWKZ0001I: Application my-app started in 1.234 seconds."}
+{"type":"liberty_message","ibm_datetime":"2020-03-02T10:15:31.123+0000","ibm_messageId":"TRAS0112W","message":"TRAS0112W: Request 1 has been running"}
+{"type":"liberty_ffdc","ibm_datetime":"2020-03-02T10:15:32.123+0000","ibm_className":"com.ibm.ws.app.manager.AppManager","ibm_exceptionName":"java.lang.NullPointerException","ibm_probeID":"123"}
+`
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/" + namespace + "/pods/" + name + "-0/log":
+			fmt.Fprint(w, logs)
+		case "/api/v1/namespaces/" + namespace + "/pods/" + name + "-1/log":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","message":"pods \"app-1\" is forbidden","reason":"Forbidden","code":403}`)
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+	defer apiServer.Close()
+	clientset, err := kubernetes.NewForConfig(&rest.Config{Host: apiServer.URL})
+	if err != nil {
+		return err
+	}
+	r.logWatcher = newLogWatcher(clientset, &rest.Config{}, record.NewFakeRecorder(100))
+	defer func() {
+		r.logWatcher.stopAll()
+		r.logWatcher = nil
+	}()
+
+	for _, n := range []string{name + "-0", name + "-1"} {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: namespace, Labels: map[string]string{"app.kubernetes.io/instance": name}},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if err := r.GetClient().Create(context.TODO(), pod); err != nil {
+			return fmt.Errorf("Create Pod (%v)", err)
+		}
+	}
+
+	req := createReconcileRequest(name, namespace)
+	openliberty := &openlibertyv1beta1.OpenLibertyApplication{}
+	if err := r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	enabled := true
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		LogWatcher: &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled, Inventory: &enabled,
+			FFDC: &openlibertyv1beta1.OpenLibertyApplicationLogWatcherFFDC{Enabled: &enabled}},
+	}
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err := r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+
+	// Wait for the followers to read the logs
+	var report logWatcherReport
+	for i := 0; i < 50; i++ {
+		report = r.logWatcher.report(openliberty)
+		if len(report.errors) > 0 && len(report.inventory) > 0 && len(report.incidents) > 0 && len(report.failures) > 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	incidents := &openlibertyv1beta1.OpenLibertyIncidents{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, incidents); err != nil {
+		return fmt.Errorf("Get OpenLibertyIncidents (%v)", err)
+	}
+	c := openliberty.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeApplicationErrors)
+	tests := []Test{
+		{"incidents", 1, len(incidents.Status.Pods)},
+		{"errors", []openlibertyv1beta1.OpenLibertyApplicationPodErrors{{PodName: name + "-0", Messages: []openlibertyv1beta1.OpenLibertyApplicationErrorMessage{
+			{MessageID: "TRAS0112W", Count: 1, Message: "TRAS0112W: Request 1 has been running"},
+		}}}, openliberty.Status.ApplicationErrors},
+		{"inventory", []openlibertyv1beta1.OpenLibertyApplicationPodInventory{{PodName: name + "-0", Applications: []string{"my-app"}}}, openliberty.Status.Inventory},
+		{"forbidden logs", corev1.ConditionUnknown, c.GetStatus()},
+		{"forbidden logs reason", "LogsUnavailable", c.GetReason()},
+		{"forbidden logs message", true, strings.Contains(c.GetMessage(), name+"-1: pods \"app-1\" is forbidden")},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// The missing OpenLibertyIncidents isn't updated while the application is paused
+	if err = r.GetClient().Delete(context.TODO(), incidents); err != nil {
+		return fmt.Errorf("Delete OpenLibertyIncidents (%v)", err)
+	}
+	pause := true
+	openliberty.Spec.Paused = &pause
+	updateOpenLiberty(r, openliberty, t)
+
+	res, err = r.Reconcile(req)
+	if err = verifyReconcile(res, err); err != nil {
+		return err
+	}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, &openlibertyv1beta1.OpenLibertyIncidents{}); err == nil {
+		return fmt.Errorf("OpenLibertyIncidents was created while paused")
+	}
+	openliberty = &openlibertyv1beta1.OpenLibertyApplication{}
+	if err = r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	tests = []Test{
+		{"reconciled while paused", corev1.ConditionTrue, openliberty.Status.GetCondition(common.StatusConditionTypeReconciled).GetStatus()},
+	}
+	if err = verifyTests(tests); err != nil {
+		return err
+	}
+
+	// No pod is followed once the manager stopped
+	r.logWatcher.stopAll()
+	r.logWatcher.sync(openliberty, []corev1.Pod{{ObjectMeta: metav1.ObjectMeta{Name: name + "-0", Namespace: namespace}}})
+	tests = []Test{
+		{"followers stopped", 0, len(r.logWatcher.followers)},
+	}
+	return verifyTests(tests)
+}
+
+// Helper Functions
+
+// statusSubresourceClient only updates the status of the applications through Status(), like the API server
+type statusSubresourceClient struct {
+	client.Client
+}
+
+func (c statusSubresourceClient) Status() client.StatusWriter {
+	return statusSubresourceWriter{c}
+}
+
+type statusSubresourceWriter struct {
+	statusSubresourceClient
+}
+
+func (w statusSubresourceWriter) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	la, ok := obj.(*openlibertyv1beta1.OpenLibertyApplication)
+	if !ok {
+		return w.Client.Status().Update(ctx, obj, opts...)
+	}
+	current := &openlibertyv1beta1.OpenLibertyApplication{}
+	if err := w.Client.Get(ctx, types.NamespacedName{Name: la.Name, Namespace: la.Namespace}, current); err != nil {
+		return err
+	}
+	current.Status = la.Status
+	return w.Client.Update(ctx, current, opts...)
+}
+
+func (w statusSubresourceWriter) Patch(ctx context.Context, obj runtime.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return w.Client.Status().Patch(ctx, obj, patch, opts...)
+}
+
+func testRemediation(t *testing.T, r *ReconcileOpenLiberty, rb autils.ReconcilerBase) error {
+	req := createReconcileRequest(name, namespace)
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name + "-2", Namespace: namespace}}
+	if err := r.GetClient().Create(context.TODO(), pod); err != nil {
+		return fmt.Errorf("Create Pod (%v)", err)
+	}
+
+	openliberty := &openlibertyv1beta1.OpenLibertyApplication{}
+	if err := r.GetClient().Get(context.TODO(), req.NamespacedName, openliberty); err != nil {
+		return fmt.Errorf("Get OpenLibertyApplication (%v)", err)
+	}
+	enabled := true
+	openliberty.Spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		LogWatcher:       &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled},
+		Remediation: &openlibertyv1beta1.OpenLibertyApplicationRemediation{
+			Rules: []openlibertyv1beta1.OpenLibertyApplicationRemediationRule{{
+				Name:      "hung",
+				MessageID: "TRAS0112W",
+				Dump: &openlibertyv1beta1.OpenLibertyApplicationRemediationDump{
+					Include: []openlibertyv1beta1.OpenLibertyDumpInclude{openlibertyv1beta1.OpenLibertyDumpIncludeThread},
+				},
+			}},
+		},
+	}
+	openliberty.Status.Remediations = nil
+
+	// A pod logging the message of the rule is dumped first
+	messages := map[string]map[string][]time.Time{pod.Name: {"TRAS0112W": {time.Now()}}}
+	if err := r.remediate(openliberty, messages, false); err != nil {
+		return fmt.Errorf("remediate: (%v)", err)
+	}
+	dump := &openlibertyv1beta1.OpenLibertyDump{}
+	if err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: pod.Name + "-hung", Namespace: namespace}, dump); err != nil {
+		return fmt.Errorf("Get OpenLibertyDump (%v)", err)
+	}
+	tests := []Test{
+		{"remediations", 1, len(openliberty.Status.Remediations)},
+		{"dumping", openlibertyv1beta1.RemediationPhaseDumping, openliberty.Status.Remediations[0].Phase},
+		{"dumped pod", pod.Name, dump.Spec.PodName},
+	}
+	if err := verifyTests(tests); err != nil {
+		return err
+	}
+
+	// The pod isn't deleted while the application is paused, even once the dump completed
+	dump.Status.Conditions = []openlibertyv1beta1.OperationStatusCondition{
+		{Type: openlibertyv1beta1.OperationStatusConditionTypeCompleted, Status: corev1.ConditionTrue},
+	}
+	if err := r.GetClient().Update(context.TODO(), dump); err != nil {
+		return fmt.Errorf("Update OpenLibertyDump (%v)", err)
+	}
+	if err := r.remediate(openliberty, nil, true); err != nil {
+		return fmt.Errorf("remediate: (%v)", err)
+	}
+	if err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: pod.Name, Namespace: namespace}, &corev1.Pod{}); err != nil {
+		return fmt.Errorf("pod deleted while paused (%v)", err)
+	}
+	if err := verifyTests([]Test{{"dumping while paused", openlibertyv1beta1.RemediationPhaseDumping, openliberty.Status.Remediations[0].Phase}}); err != nil {
+		return err
+	}
+
+	// The action runs once the application is resumed
+	if err := r.remediate(openliberty, nil, false); err != nil {
+		return fmt.Errorf("remediate: (%v)", err)
+	}
+	if err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: pod.Name, Namespace: namespace}, &corev1.Pod{}); err == nil {
+		return fmt.Errorf("remediated pod was not deleted")
+	}
+	return verifyTests([]Test{{"completed", openlibertyv1beta1.RemediationPhaseCompleted, openliberty.Status.Remediations[0].Phase}})
+}
 func createOpenLibertyApp(n, ns string, spec openlibertyv1beta1.OpenLibertyApplicationSpec) *openlibertyv1beta1.OpenLibertyApplication {
 	app := &openlibertyv1beta1.OpenLibertyApplication{
 		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
@@ -0,0 +1,135 @@
+package openliberty
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	lutils "github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// remediate runs the actions of the remediations waiting for their dump, then starts the remediations of the pods
+// that triggered a rule, within the limits of the application. Neither actions nor remediations run while the
+// application is paused.
+func (r *ReconcileOpenLiberty) remediate(instance *openlibertyv1beta1.OpenLibertyApplication, messages map[string]map[string][]time.Time, paused bool) error {
+	if paused {
+		return nil
+	}
+	for i := range instance.Status.Remediations {
+		rec := &instance.Status.Remediations[i]
+		if rec.Phase != openlibertyv1beta1.RemediationPhaseDumping {
+			continue
+		}
+		done, err := r.isRemediationDumpDone(instance, rec)
+		if err != nil {
+			return err
+		}
+		if done {
+			r.runRemediationAction(instance, rec)
+		}
+	}
+
+	if !lutils.IsRemediationEnabled(instance) {
+		return nil
+	}
+	now := time.Now()
+	pods := []string{}
+	for pod := range messages {
+		pods = append(pods, pod)
+	}
+	sort.Strings(pods)
+	for _, pod := range pods {
+		for i := range instance.GetRemediation().Rules {
+			rule := &instance.GetRemediation().Rules[i]
+			count := lutils.CountMessages(messages[pod][rule.MessageID], now, lutils.GetRemediationRulePeriod(rule))
+			if count < lutils.GetRemediationRuleThreshold(rule) || lutils.FindRemediation(instance, pod, rule.Name) != nil {
+				continue
+			}
+			trigger := fmt.Sprintf("Pod %s logged %s %d times in %s", pod, rule.MessageID, count, lutils.GetRemediationRulePeriod(rule))
+			if limit := lutils.GetRemediationLimit(instance, now); limit != "" {
+				r.GetRecorder().Event(instance, corev1.EventTypeWarning, "RemediationSkipped", trigger+", but "+limit)
+				return nil
+			}
+			if err := r.startRemediation(instance, pod, rule, count); err != nil {
+				return err
+			}
+			r.GetRecorder().Event(instance, corev1.EventTypeWarning, "Remediation", trigger+", started remediation "+rule.Name)
+		}
+	}
+	return nil
+}
+
+// startRemediation requests the dump of the rule, or runs its action when it doesn't take one
+func (r *ReconcileOpenLiberty) startRemediation(instance *openlibertyv1beta1.OpenLibertyApplication, pod string, rule *openlibertyv1beta1.OpenLibertyApplicationRemediationRule, count int32) error {
+	rec := openlibertyv1beta1.OpenLibertyApplicationRemediationRecord{
+		Rule:      rule.Name,
+		PodName:   pod,
+		Count:     count,
+		Action:    lutils.GetRemediationRuleAction(rule),
+		Phase:     openlibertyv1beta1.RemediationPhaseDumping,
+		StartTime: metav1.Now(),
+	}
+	if rule.Dump != nil {
+		dump := &openlibertyv1beta1.OpenLibertyDump{
+			ObjectMeta: metav1.ObjectMeta{Name: lutils.GetRemediationDumpName(pod, rule.Name), Namespace: instance.Namespace},
+		}
+		err := r.CreateOrUpdate(dump, instance, func() error {
+			dump.Spec = openlibertyv1beta1.OpenLibertyDumpSpec{PodName: pod, Include: rule.Dump.Include}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		rec.DumpName = dump.Name
+		lutils.AddRemediation(instance, rec)
+		return nil
+	}
+	lutils.AddRemediation(instance, rec)
+	r.runRemediationAction(instance, &instance.Status.Remediations[len(instance.Status.Remediations)-1])
+	return nil
+}
+
+// isRemediationDumpDone returns true when the dump of the remediation completed or failed, or timed out
+func (r *ReconcileOpenLiberty) isRemediationDumpDone(instance *openlibertyv1beta1.OpenLibertyApplication, rec *openlibertyv1beta1.OpenLibertyApplicationRemediationRecord) (bool, error) {
+	if rec.DumpName == "" || time.Since(rec.StartTime.Time) > lutils.RemediationDumpTimeout {
+		return true, nil
+	}
+	dump := &openlibertyv1beta1.OpenLibertyDump{}
+	err := r.GetClient().Get(context.TODO(), types.NamespacedName{Name: rec.DumpName, Namespace: instance.Namespace}, dump)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	if c := openlibertyv1beta1.GetOperationCondtion(dump.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeCompleted); c != nil {
+		return true, nil
+	}
+	c := openlibertyv1beta1.GetOperationCondtion(dump.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeStarted)
+	return c != nil && c.Status == corev1.ConditionFalse, nil
+}
+
+// runRemediationAction runs the action of the remediation on its pod and records the result
+func (r *ReconcileOpenLiberty) runRemediationAction(instance *openlibertyv1beta1.OpenLibertyApplication, rec *openlibertyv1beta1.OpenLibertyApplicationRemediationRecord) {
+	if rec.Action != openlibertyv1beta1.RemediationActionDelete {
+		lutils.SetRemediationCompleted(rec, nil, "")
+		return
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: rec.PodName, Namespace: instance.Namespace}}
+	err := r.GetClient().Delete(context.TODO(), pod)
+	if err != nil && errors.IsNotFound(err) {
+		lutils.SetRemediationCompleted(rec, nil, "The pod was already deleted")
+		return
+	}
+	if err != nil {
+		log.Error(err, "Failed to delete the remediated pod", "pod", rec.PodName, "rule", rec.Rule)
+		r.GetRecorder().Event(instance, corev1.EventTypeWarning, "ProcessingError", "Failed to delete pod "+rec.PodName+": "+err.Error())
+	}
+	lutils.SetRemediationCompleted(rec, err, "Deleted the pod")
+}
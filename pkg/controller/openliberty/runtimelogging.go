@@ -0,0 +1,79 @@
+package openliberty
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	lutils "github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// runtimeLoggingRetryDelay is the delay before the runtime logging overrides are applied again to the pods that failed
+const runtimeLoggingRetryDelay = 30 * time.Second
+
+// applyRuntimeLogging writes the runtime logging overrides to the running servers of the application that don't have
+// them yet, e.g. because their container started since, or removes them, and reports the result of each pod in the
+// status. The overrides are not changed while the application is paused. It returns the delay before the pods that
+// failed are tried again.
+func (r *ReconcileOpenLiberty) applyRuntimeLogging(instance *openlibertyv1beta1.OpenLibertyApplication, paused bool) (time.Duration, error) {
+	if r.restConfig == nil {
+		return 0, nil
+	}
+	pods := &corev1.PodList{}
+	err := r.GetClient().List(context.TODO(), pods, client.InNamespace(instance.Namespace),
+		client.MatchingLabels{"app.kubernetes.io/instance": instance.Name})
+	if err != nil {
+		return 0, err
+	}
+
+	hash := lutils.GetRuntimeLoggingHash(instance)
+	statuses := []openlibertyv1beta1.OpenLibertyApplicationPodRuntimeLogging{}
+	retry := time.Duration(0)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		status := openlibertyv1beta1.OpenLibertyApplicationPodRuntimeLogging{PodName: pod.Name, Phase: openlibertyv1beta1.RuntimeLoggingPhaseApplied}
+		switch {
+		case lutils.GetLibertyContainerID(pod) == "":
+			status.Phase = openlibertyv1beta1.RuntimeLoggingPhasePending
+		case lutils.GetAppliedRuntimeLoggingHash(pod) == hash:
+		case paused:
+			status.Phase, status.Message = openlibertyv1beta1.RuntimeLoggingPhasePending, "The application is paused"
+		default:
+			if err := r.writeRuntimeLogging(instance, pod, hash); err != nil {
+				log.Error(err, "Failed to apply the runtime logging overrides", "pod", pod.Name)
+				r.GetRecorder().Event(instance, corev1.EventTypeWarning, "ProcessingError", "Failed to apply the runtime logging overrides to pod "+pod.Name+": "+err.Error())
+				status.Phase, status.Message = openlibertyv1beta1.RuntimeLoggingPhaseFailed, err.Error()
+				retry = runtimeLoggingRetryDelay
+			}
+		}
+		if hash != "" {
+			statuses = append(statuses, status)
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].PodName < statuses[j].PodName
+	})
+	if len(statuses) == 0 {
+		statuses = nil
+	}
+	instance.Status.RuntimeLogging = statuses
+	return retry, nil
+}
+
+// writeRuntimeLogging writes or removes the configDropin of the runtime logging overrides in the container of the
+// server, and records the overrides it has on the pod
+func (r *ReconcileOpenLiberty) writeRuntimeLogging(instance *openlibertyv1beta1.OpenLibertyApplication, pod *corev1.Pod, hash string) error {
+	_, err := lutils.ExecuteCommandInContainer(r.restConfig, pod.Name, pod.Namespace, lutils.GetLibertyContainerName(pod), lutils.GetRuntimeLoggingCommand(instance))
+	if err != nil {
+		return err
+	}
+	lutils.SetAppliedRuntimeLoggingHash(pod, hash)
+	return r.GetClient().Update(context.TODO(), pod)
+}
@@ -0,0 +1,230 @@
+package openlibertybatchjob
+
+import (
+	"context"
+	"os"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var log = logf.Log.WithName("controller_openlibertybatchjob")
+
+// Add creates a new OpenLibertyBatchJob Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileOpenLibertyBatchJob{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor("open-liberty-operator")}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("openlibertybatchjob-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	watchNamespaces, err := utils.GetWatchNamespaces()
+	if err != nil {
+		log.Error(err, "Failed to get watch namespace")
+		os.Exit(1)
+	}
+
+	watchNamespacesMap := make(map[string]bool)
+	for _, ns := range watchNamespaces {
+		watchNamespacesMap[ns] = true
+	}
+	isClusterWide := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
+
+	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "isClusterWide", isClusterWide)
+
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Ignore updates to CR status in which case metadata.Generation does not change
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+	}
+
+	// Watch for changes to primary resource OpenLibertyBatchJob
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyBatchJob{}}, &handler.EnqueueRequestForObject{}, pred)
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the Jobs running the batch jobs
+	err = c.Watch(&source.Kind{Type: &batchv1.Job{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &openlibertyv1beta1.OpenLibertyBatchJob{},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileOpenLibertyBatchJob implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileOpenLibertyBatchJob{}
+
+// ReconcileOpenLibertyBatchJob reconciles a OpenLibertyBatchJob object
+type ReconcileOpenLibertyBatchJob struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// Reconcile reads that state of the cluster for a OpenLibertyBatchJob object and makes changes based on the state read
+// and what is in the OpenLibertyBatchJob.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileOpenLibertyBatchJob) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling OpenLibertyBatchJob")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyBatchJob", result, reason, time.Since(start))
+	}()
+
+	// Fetch the OpenLibertyBatchJob instance
+	instance := &openlibertyv1beta1.OpenLibertyBatchJob{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	//do not reconcile if the batch job already finished
+	if openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeCompleted) != nil {
+		return reconcile.Result{}, nil
+	}
+
+	job := &batchv1.Job{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, job)
+	if err != nil && errors.IsNotFound(err) {
+		if err := r.startBatchJob(instance); err != nil {
+			message := "Failed to start batch job: " + err.Error()
+			log.Error(err, message)
+			r.recorder.Event(instance, "Warning", "ProcessingError", message)
+			c := openlibertyv1beta1.OperationStatusCondition{
+				Type:    openlibertyv1beta1.OperationStatusConditionTypeStarted,
+				Status:  corev1.ConditionFalse,
+				Reason:  "Error",
+				Message: err.Error(),
+			}
+			instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
+			r.client.Status().Update(context.TODO(), instance)
+			result, reason = utils.MetricsResultError, "StartFailed"
+		}
+		return reconcile.Result{}, nil
+	} else if err != nil {
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	finished, succeeded, message := utils.IsJobFinished(job)
+	if !finished {
+		return reconcile.Result{}, nil
+	}
+
+	pods := &corev1.PodList{}
+	err = r.client.List(context.TODO(), pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name})
+	if err != nil {
+		result, reason = utils.MetricsResultError, "ListFailed"
+		return reconcile.Result{}, err
+	}
+	if pod := utils.GetBatchJobPod(pods.Items); pod != nil {
+		instance.Status.Execution = utils.GetBatchJobExecution(pod)
+		if instance.Spec.Serviceability != nil {
+			instance.Status.LogFile = utils.GetBatchJobLogFile(pod.Namespace, pod.Name)
+		}
+	}
+
+	c := openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeCompleted,
+		Status: corev1.ConditionTrue,
+	}
+	if !succeeded {
+		c.Status, c.Reason, c.Message = corev1.ConditionFalse, "Failed", message
+		if instance.Status.Execution != nil && instance.Status.Execution.BatchStatus != "" {
+			c.Message = "The batch job ended with batch status " + instance.Status.Execution.BatchStatus +
+				" and exit status " + instance.Status.Execution.ExitStatus
+		}
+		r.recorder.Event(instance, "Warning", "BatchJobFailed", c.Message)
+		result, reason = utils.MetricsResultError, "BatchJobFailed"
+	}
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
+	err = r.client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		result, reason = utils.MetricsResultError, "StatusUpdateFailed"
+	}
+	return reconcile.Result{}, err
+}
+
+// startBatchJob creates the Job running the batch job and sets the Started condition
+func (r *ReconcileOpenLibertyBatchJob) startBatchJob(instance *openlibertyv1beta1.OpenLibertyBatchJob) error {
+	secret := &corev1.Secret{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.CredentialsSecret, Namespace: instance.Namespace}, secret)
+	if err != nil {
+		return err
+	}
+
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	job.Labels = utils.GetBatchJobLabels(instance.Name)
+	utils.CustomizeBatchJob(&job.Spec, &instance.Spec, utils.GetBatchJobLabels(instance.Name), instance.Namespace)
+	if err := controllerutil.SetControllerReference(instance, job, r.scheme); err != nil {
+		return err
+	}
+	if err := r.client.Create(context.TODO(), job); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	instance.Status.JobName = job.Name
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeStarted,
+		Status: corev1.ConditionTrue,
+	})
+	return r.client.Status().Update(context.TODO(), instance)
+}
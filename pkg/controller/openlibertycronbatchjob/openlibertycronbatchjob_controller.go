@@ -0,0 +1,201 @@
+package openlibertycronbatchjob
+
+import (
+	"context"
+	"os"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var log = logf.Log.WithName("controller_openlibertycronbatchjob")
+
+// Add creates a new OpenLibertyCronBatchJob Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileOpenLibertyCronBatchJob{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor("open-liberty-operator")}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("openlibertycronbatchjob-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	watchNamespaces, err := utils.GetWatchNamespaces()
+	if err != nil {
+		log.Error(err, "Failed to get watch namespace")
+		os.Exit(1)
+	}
+
+	watchNamespacesMap := make(map[string]bool)
+	for _, ns := range watchNamespaces {
+		watchNamespacesMap[ns] = true
+	}
+	isClusterWide := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
+
+	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "isClusterWide", isClusterWide)
+
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Ignore updates to CR status in which case metadata.Generation does not change
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+	}
+
+	// Watch for changes to primary resource OpenLibertyCronBatchJob
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyCronBatchJob{}}, &handler.EnqueueRequestForObject{}, pred)
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the CronJobs scheduling the batch jobs
+	err = c.Watch(&source.Kind{Type: &batchv1beta1.CronJob{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &openlibertyv1beta1.OpenLibertyCronBatchJob{},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The Jobs are owned by the CronJobs, so they are mapped to their OpenLibertyCronBatchJob with a label
+	err = c.Watch(&source.Kind{Type: &batchv1.Job{}}, &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+			name, ok := a.Meta.GetLabels()[utils.CronBatchJobLabel]
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: a.Meta.GetNamespace()}}}
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileOpenLibertyCronBatchJob implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileOpenLibertyCronBatchJob{}
+
+// ReconcileOpenLibertyCronBatchJob reconciles a OpenLibertyCronBatchJob object
+type ReconcileOpenLibertyCronBatchJob struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// Reconcile reads that state of the cluster for a OpenLibertyCronBatchJob object and makes changes based on the state read
+// and what is in the OpenLibertyCronBatchJob.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileOpenLibertyCronBatchJob) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling OpenLibertyCronBatchJob")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyCronBatchJob", result, reason, time.Since(start))
+	}()
+
+	// Fetch the OpenLibertyCronBatchJob instance
+	instance := &openlibertyv1beta1.OpenLibertyCronBatchJob{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	cronJob := &batchv1beta1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace}}
+	_, err = controllerutil.CreateOrUpdate(context.TODO(), r.client, cronJob, func() error {
+		utils.CustomizeCronBatchJob(cronJob, instance)
+		return controllerutil.SetControllerReference(instance, cronJob, r.scheme)
+	})
+	if err != nil {
+		log.Error(err, "Failed to reconcile CronJob "+cronJob.Name)
+		r.recorder.Event(instance, "Warning", "ProcessingError", err.Error())
+		result, reason = utils.MetricsResultError, "CronJobFailed"
+		return reconcile.Result{}, err
+	}
+
+	instance.Status.LastScheduleTime = cronJob.Status.LastScheduleTime
+	instance.Status.Active = nil
+	for _, ref := range cronJob.Status.Active {
+		instance.Status.Active = append(instance.Status.Active, ref.Name)
+	}
+
+	jobs := &batchv1.JobList{}
+	err = r.client.List(context.TODO(), jobs, client.InNamespace(instance.Namespace), client.MatchingLabels{utils.CronBatchJobLabel: instance.Name})
+	if err != nil {
+		result, reason = utils.MetricsResultError, "ListFailed"
+		return reconcile.Result{}, err
+	}
+	// Keep the last execution once its Job is removed from the history
+	if job := utils.GetLatestFinishedJob(jobs.Items); job != nil && job.Name != instance.Status.LastJobName {
+		pods := &corev1.PodList{}
+		err = r.client.List(context.TODO(), pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name})
+		if err != nil {
+			result, reason = utils.MetricsResultError, "ListFailed"
+			return reconcile.Result{}, err
+		}
+		instance.Status.LastJobName = job.Name
+		instance.Status.LastExecution = nil
+		if pod := utils.GetBatchJobPod(pods.Items); pod != nil {
+			instance.Status.LastExecution = utils.GetBatchJobExecution(pod)
+		}
+		if _, succeeded, message := utils.IsJobFinished(job); !succeeded {
+			r.recorder.Event(instance, "Warning", "BatchJobFailed", "Job "+job.Name+" failed: "+message)
+		}
+	}
+
+	err = r.client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		result, reason = utils.MetricsResultError, "StatusUpdateFailed"
+	}
+	return reconcile.Result{}, err
+}
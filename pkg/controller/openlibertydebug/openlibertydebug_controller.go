@@ -0,0 +1,292 @@
+package openlibertydebug
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const defaultDuration = time.Hour
+
+var log = logf.Log.WithName("controller_openlibertydebug")
+
+// Add creates a new OpenLibertyDebug Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileOpenLibertyDebug{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor("open-liberty-operator")}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("openlibertydebug-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	watchNamespaces, err := utils.GetWatchNamespaces()
+	if err != nil {
+		log.Error(err, "Failed to get watch namespace")
+		os.Exit(1)
+	}
+
+	watchNamespacesMap := make(map[string]bool)
+	for _, ns := range watchNamespaces {
+		watchNamespacesMap[ns] = true
+	}
+	isClusterWide := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
+
+	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "isClusterWide", isClusterWide)
+
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Ignore updates to CR status in which case metadata.Generation does not change
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+	}
+
+	// Watch for changes to primary resource OpenLibertyDebug
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyDebug{}}, &handler.EnqueueRequestForObject{}, pred)
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the debug pods, to report whether they are running
+	err = c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &openlibertyv1beta1.OpenLibertyDebug{},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileOpenLibertyDebug implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileOpenLibertyDebug{}
+
+// ReconcileOpenLibertyDebug reconciles a OpenLibertyDebug object
+type ReconcileOpenLibertyDebug struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// Reconcile reads that state of the cluster for a OpenLibertyDebug object and makes changes based on the state read
+// and what is in the OpenLibertyDebug.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileOpenLibertyDebug) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling OpenLibertyDebug")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyDebug", result, reason, time.Since(start))
+	}()
+
+	// Fetch the OpenLibertyDebug instance
+	instance := &openlibertyv1beta1.OpenLibertyDebug{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	//do not reconcile if the debug session is over
+	oc := openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeCompleted)
+	if oc != nil && oc.Status == corev1.ConditionTrue {
+		return reconcile.Result{}, nil
+	}
+
+	oc = openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeStarted)
+	if oc == nil || oc.Status != corev1.ConditionTrue {
+		if err := r.startDebug(instance); err != nil {
+			message := "Failed to start debugging: " + err.Error()
+			log.Error(err, message)
+			r.recorder.Event(instance, "Warning", "ProcessingError", message)
+			c := openlibertyv1beta1.OperationStatusCondition{
+				Type:    openlibertyv1beta1.OperationStatusConditionTypeStarted,
+				Status:  corev1.ConditionFalse,
+				Reason:  "Error",
+				Message: err.Error(),
+			}
+			instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
+			r.client.Status().Update(context.TODO(), instance)
+			result, reason = utils.MetricsResultError, "StartFailed"
+			return reconcile.Result{}, nil
+		}
+		// The debug pod is reported once it shows up in the cache
+		return reconcile.Result{RequeueAfter: time.Until(instance.Status.ExpirationTime.Time)}, nil
+	}
+
+	debugPod := &corev1.Pod{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Status.DebugPodName, Namespace: instance.Namespace}, debugPod)
+	if err != nil && !errors.IsNotFound(err) {
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	remaining := time.Until(instance.Status.ExpirationTime.Time)
+	if remaining <= 0 || errors.IsNotFound(err) {
+		completedReason, message := "Expired", "The debug pod was removed after "+getDuration(instance).String()
+		if remaining > 0 {
+			completedReason, message = "PodDeleted", "The debug pod was deleted"
+		} else if err == nil {
+			if err := r.client.Delete(context.TODO(), debugPod); err != nil && !errors.IsNotFound(err) {
+				result, reason = utils.MetricsResultError, "DeleteFailed"
+				return reconcile.Result{}, err
+			}
+		}
+		instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+			Type:   openlibertyv1beta1.OperationStatusConditionTypeEnabled,
+			Status: corev1.ConditionFalse,
+			Reason: completedReason,
+		})
+		instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+			Type:    openlibertyv1beta1.OperationStatusConditionTypeCompleted,
+			Status:  corev1.ConditionTrue,
+			Reason:  completedReason,
+			Message: message,
+		})
+		instance.Status.PortForwardCommand = ""
+		err = r.client.Status().Update(context.TODO(), instance)
+		if err != nil {
+			result, reason = utils.MetricsResultError, "StatusUpdateFailed"
+		}
+		return reconcile.Result{}, err
+	}
+
+	c := openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeEnabled,
+		Status: corev1.ConditionTrue,
+	}
+	if debugPod.Status.Phase != corev1.PodRunning {
+		c.Status, c.Reason = corev1.ConditionFalse, "Pod"+string(debugPod.Status.Phase)
+		c.Message = "The debug pod is not running"
+	}
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
+	err = r.client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		result, reason = utils.MetricsResultError, "StatusUpdateFailed"
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{RequeueAfter: remaining}, nil
+}
+
+// startDebug creates the debug pod from the target pod and sets the Started condition
+func (r *ReconcileOpenLibertyDebug) startDebug(instance *openlibertyv1beta1.OpenLibertyDebug) error {
+	target, err := r.getTargetPod(instance)
+	if err != nil {
+		return err
+	}
+
+	port := utils.DefaultDebugPort
+	if instance.Spec.Port != nil {
+		port = *instance.Spec.Port
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-debug", Namespace: instance.Namespace}}
+	utils.CustomizeDebugPod(pod, target, port, instance.Spec.Suspend != nil && *instance.Spec.Suspend)
+	if err := controllerutil.SetControllerReference(instance, pod, r.scheme); err != nil {
+		return err
+	}
+	if err := r.client.Create(context.TODO(), pod); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	instance.Status.OperatedResource = openlibertyv1beta1.OperatedResource{ResourceType: "Pod", ResourceName: target.Name}
+	instance.Status.DebugPodName = pod.Name
+	instance.Status.DebugPort = port
+	instance.Status.PortForwardCommand = fmt.Sprintf("kubectl port-forward pod/%s %d:%d -n %s", pod.Name, port, port, pod.Namespace)
+	instance.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(getDuration(instance))}
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeStarted,
+		Status: corev1.ConditionTrue,
+	})
+	r.recorder.Event(instance, "Normal", "DebugStarted", "Created pod "+pod.Name+" to debug pod "+target.Name)
+	return r.client.Status().Update(context.TODO(), instance)
+}
+
+// getTargetPod returns the pod named in the spec, or the first running pod of the application
+func (r *ReconcileOpenLibertyDebug) getTargetPod(instance *openlibertyv1beta1.OpenLibertyDebug) (*corev1.Pod, error) {
+	if instance.Spec.PodName != "" {
+		pod := &corev1.Pod{}
+		err := r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.PodName, Namespace: instance.Namespace}, pod)
+		if err != nil {
+			return nil, err
+		}
+		return pod, nil
+	}
+	if instance.Spec.ApplicationName == "" {
+		return nil, fmt.Errorf("Either podName or applicationName must be set")
+	}
+
+	pods := &corev1.PodList{}
+	err := r.client.List(context.TODO(), pods, client.InNamespace(instance.Namespace),
+		client.MatchingLabels{"app.kubernetes.io/instance": instance.Spec.ApplicationName})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].Name < pods.Items[j].Name
+	})
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning && pods.Items[i].DeletionTimestamp == nil {
+			return &pods.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("Failed to find a running pod of application %s in namespace %s", instance.Spec.ApplicationName, instance.Namespace)
+}
+
+func getDuration(instance *openlibertyv1beta1.OpenLibertyDebug) time.Duration {
+	if d, err := time.ParseDuration(instance.Spec.Duration); err == nil {
+		return d
+	}
+	return defaultDuration
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
-	autils "github.com/appsody/appsody-operator/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -46,7 +45,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
-	watchNamespaces, err := autils.GetWatchNamespaces()
+	watchNamespaces, err := utils.GetWatchNamespaces()
 	if err != nil {
 		log.Error(err, "Failed to get watch namespace")
 		os.Exit(1)
@@ -106,6 +105,11 @@ type ReconcileOpenLibertyDump struct {
 func (r *ReconcileOpenLibertyDump) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	reqLogger.Info("Reconciling OpenLibertyDump")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyDump", result, reason, time.Since(start))
+	}()
 
 	// Fetch the OpenLibertyDump instance
 	instance := &openlibertyv1beta1.OpenLibertyDump{}
@@ -118,6 +122,7 @@ func (r *ReconcileOpenLibertyDump) Reconcile(request reconcile.Request) (reconci
 			return reconcile.Result{}, nil
 		}
 		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
 		return reconcile.Result{}, err
 	}
 
@@ -144,12 +149,13 @@ func (r *ReconcileOpenLibertyDump) Reconcile(request reconcile.Request) (reconci
 		}
 		instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
 		r.client.Status().Update(context.TODO(), instance)
+		result, reason = utils.MetricsResultError, "PodNotFound"
 		return reconcile.Result{}, nil
 	}
 
-	time := time.Now()
+	now := time.Now()
 	dumpFolder := "/serviceability/" + pod.Namespace + "/" + pod.Name
-	dumpFileName := dumpFolder + "/" + time.Format("2006-01-02_15:04:05") + ".zip"
+	dumpFileName := dumpFolder + "/" + now.Format("2006-01-02_15:04:05") + ".zip"
 	dumpCmd := "mkdir -p " + dumpFolder + " &&  server dump --archive=" + dumpFileName
 	if len(instance.Spec.Include) > 0 {
 		dumpCmd += " --include="
@@ -166,8 +172,10 @@ func (r *ReconcileOpenLibertyDump) Reconcile(request reconcile.Request) (reconci
 	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
 	r.client.Status().Update(context.TODO(), instance)
 
-	_, err = utils.ExecuteCommandInContainer(r.restConfig, pod.Name, pod.Namespace, "app", []string{"/bin/sh", "-c", dumpCmd})
+	_, err = utils.ExecuteCommandInContainer(r.restConfig, pod.Name, pod.Namespace, utils.GetLibertyContainerName(pod), []string{"/bin/sh", "-c", dumpCmd})
 	if err != nil {
+		utils.RecordDump(instance.Spec.Include, time.Since(now), 0, err)
+		result, reason = utils.MetricsResultError, "DumpFailed"
 		//handle error
 		log.Error(err, "Execute dump cmd failed ", "cmd", dumpCmd)
 		r.recorder.Event(instance, "Warning", "ProcessingError", err.Error())
@@ -183,6 +191,8 @@ func (r *ReconcileOpenLibertyDump) Reconcile(request reconcile.Request) (reconci
 
 	}
 
+	utils.RecordDump(instance.Spec.Include, time.Since(now), r.getArchiveSize(pod, dumpFileName), nil)
+
 	c = openlibertyv1beta1.OperationStatusCondition{
 		Type:   openlibertyv1beta1.OperationStatusConditionTypeCompleted,
 		Status: corev1.ConditionTrue,
@@ -193,3 +203,13 @@ func (r *ReconcileOpenLibertyDump) Reconcile(request reconcile.Request) (reconci
 	r.client.Status().Update(context.TODO(), instance)
 	return reconcile.Result{}, nil
 }
+
+// getArchiveSize returns the size in bytes of the dump archive, or 0 when it can't be read
+func (r *ReconcileOpenLibertyDump) getArchiveSize(pod *corev1.Pod, dumpFileName string) int64 {
+	out, err := utils.ExecuteCommandInContainer(r.restConfig, pod.Name, pod.Namespace, utils.GetLibertyContainerName(pod), utils.GetArchiveSizeCommand(dumpFileName))
+	if err != nil {
+		log.Error(err, "Failed to get the size of the dump archive", "file", dumpFileName)
+		return 0
+	}
+	return utils.ParseArchiveSize(out)
+}
@@ -0,0 +1,388 @@
+package openlibertypause
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const pauseFinalizer = "finalizer.openlibertypauses.openliberty.io"
+
+var log = logf.Log.WithName("controller_openlibertypause")
+
+// Add creates a new OpenLibertyPause Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	if err := add(mgr, newReconciler(mgr)); err != nil {
+		return err
+	}
+	return addPodController(mgr, &ReconcilePausePod{client: mgr.GetClient()})
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileOpenLibertyPause{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor("open-liberty-operator"), restConfig: mgr.GetConfig()}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("openlibertypause-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	watchNamespaces, err := utils.GetWatchNamespaces()
+	if err != nil {
+		log.Error(err, "Failed to get watch namespace")
+		os.Exit(1)
+	}
+
+	watchNamespacesMap := make(map[string]bool)
+	for _, ns := range watchNamespaces {
+		watchNamespacesMap[ns] = true
+	}
+	isClusterWide := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
+
+	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "isClusterWide", isClusterWide)
+
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Ignore updates to CR status in which case metadata.Generation does not change
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+	}
+
+	// Watch for changes to primary resource OpenLibertyPause
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyPause{}}, &handler.EnqueueRequestForObject{}, pred)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileOpenLibertyPause implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileOpenLibertyPause{}
+
+// ReconcileOpenLibertyPause reconciles a OpenLibertyPause object
+type ReconcileOpenLibertyPause struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client     client.Client
+	scheme     *runtime.Scheme
+	recorder   record.EventRecorder
+	restConfig *rest.Config
+}
+
+// Reconcile reads that state of the cluster for a OpenLibertyPause object and makes changes based on the state read
+// and what is in the OpenLibertyPause.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileOpenLibertyPause) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling OpenLibertyPause")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyPause", result, reason, time.Since(start))
+	}()
+
+	// Fetch the OpenLibertyPause instance
+	instance := &openlibertyv1beta1.OpenLibertyPause{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	// Check if the OpenLibertyPause instance is marked to be deleted, which is
+	// indicated by the deletion timestamp being set.
+	if instance.GetDeletionTimestamp() != nil {
+		if contains(instance.GetFinalizers(), pauseFinalizer) {
+			// Resume the paused pods. If resuming fails, don't remove the finalizer so that we can retry
+			// during the next reconciliation.
+			if err := r.resumePods(reqLogger, instance); err != nil {
+				result, reason = utils.MetricsResultError, "FinalizerFailed"
+				return reconcile.Result{}, err
+			}
+
+			// Remove pauseFinalizer. Once all finalizers have been removed, the object will be deleted.
+			instance.SetFinalizers(remove(instance.GetFinalizers(), pauseFinalizer))
+			err := r.client.Update(context.TODO(), instance)
+			if err != nil {
+				result, reason = utils.MetricsResultError, "FinalizerFailed"
+				return reconcile.Result{}, err
+			}
+		}
+		return reconcile.Result{}, nil
+	}
+
+	//do not reconcile if the pods were already resumed
+	oc := openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeCompleted)
+	if oc != nil && oc.Status == corev1.ConditionTrue {
+		return reconcile.Result{}, nil
+	}
+
+	// Add finalizer for this CR
+	if !contains(instance.GetFinalizers(), pauseFinalizer) {
+		if err := r.addFinalizer(reqLogger, instance); err != nil {
+			result, reason = utils.MetricsResultError, "FinalizerFailed"
+			return reconcile.Result{}, err
+		}
+	}
+
+	oc = openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeStarted)
+	expired := instance.Status.ExpirationTime != nil && !time.Now().Before(instance.Status.ExpirationTime.Time)
+	if (oc == nil || oc.Status != corev1.ConditionTrue) && !expired {
+		if err := r.startPause(reqLogger, instance); err != nil {
+			message := "Failed to pause the server: " + err.Error()
+			log.Error(err, message)
+			r.recorder.Event(instance, "Warning", "ProcessingError", message)
+			c := openlibertyv1beta1.OperationStatusCondition{
+				Type:    openlibertyv1beta1.OperationStatusConditionTypeStarted,
+				Status:  corev1.ConditionFalse,
+				Reason:  "Error",
+				Message: err.Error(),
+			}
+			instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
+			r.client.Status().Update(context.TODO(), instance)
+			result, reason = utils.MetricsResultError, "PauseFailed"
+			// The pods that were paused before the failure are still resumed when the pause expires
+			if instance.Status.ExpirationTime == nil {
+				return reconcile.Result{}, nil
+			}
+		}
+	}
+
+	if instance.Status.ExpirationTime == nil {
+		return reconcile.Result{}, nil
+	}
+	remaining := time.Until(instance.Status.ExpirationTime.Time)
+	if remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if err := r.resumePods(reqLogger, instance); err != nil {
+		result, reason = utils.MetricsResultError, "ResumeFailed"
+		return reconcile.Result{}, err
+	}
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeEnabled,
+		Status: corev1.ConditionFalse,
+		Reason: "Expired",
+	})
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:    openlibertyv1beta1.OperationStatusConditionTypeCompleted,
+		Status:  corev1.ConditionTrue,
+		Reason:  "Expired",
+		Message: "The pods were resumed after " + instance.Spec.Duration,
+	})
+	err = r.client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		result, reason = utils.MetricsResultError, "StatusUpdateFailed"
+		return reconcile.Result{}, err
+	}
+
+	// The pods are resumed, nothing is left to clean up on deletion
+	instance.SetFinalizers(remove(instance.GetFinalizers(), pauseFinalizer))
+	err = r.client.Update(context.TODO(), instance)
+	if err != nil {
+		result, reason = utils.MetricsResultError, "FinalizerFailed"
+	}
+	return reconcile.Result{}, err
+}
+
+// startPause pauses the servers of the target pods and sets the Started and Enabled conditions. The pods that were
+// paused are recorded even when pausing another pod fails, so that they are resumed on deletion or expiration. The
+// expiration time is set before the first pod is paused and isn't extended when pausing is retried.
+func (r *ReconcileOpenLibertyPause) startPause(reqLogger logr.Logger, instance *openlibertyv1beta1.OpenLibertyPause) error {
+	if instance.Spec.Duration != "" && instance.Status.ExpirationTime == nil {
+		d, err := time.ParseDuration(instance.Spec.Duration)
+		if err != nil {
+			return err
+		}
+		instance.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(d)}
+	}
+
+	pods, err := r.getTargetPods(instance)
+	if err != nil {
+		return err
+	}
+
+	for i := range pods {
+		pod := &pods[i]
+		if contains(instance.Status.PausedPods, pod.Name) {
+			continue
+		}
+		cmd := utils.GetServerPauseCommand("pause", instance.Spec.Targets, pod)
+		reqLogger.Info("Pausing the server", "pod", pod.Name, "cmd", cmd)
+		_, err = utils.ExecuteCommandInContainer(r.restConfig, pod.Name, pod.Namespace, utils.GetLibertyContainerName(pod), []string{"/bin/sh", "-c", cmd})
+		if err != nil {
+			r.client.Status().Update(context.TODO(), instance)
+			return fmt.Errorf("Failed to pause the server of pod %s: %v", pod.Name, err)
+		}
+		instance.Status.PausedPods = append(instance.Status.PausedPods, pod.Name)
+		if err := r.setServingCondition(pod, corev1.ConditionFalse, "Paused", "The server was paused by OpenLibertyPause "+instance.Name); err != nil {
+			r.client.Status().Update(context.TODO(), instance)
+			return err
+		}
+	}
+
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeStarted,
+		Status: corev1.ConditionTrue,
+	})
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeEnabled,
+		Status: corev1.ConditionTrue,
+	})
+	r.recorder.Event(instance, "Normal", "Paused", "Paused the servers of pods "+strings.Join(instance.Status.PausedPods, ", "))
+	return r.client.Status().Update(context.TODO(), instance)
+}
+
+// resumePods resumes the servers of the paused pods. The pods that are gone or no longer running are skipped.
+func (r *ReconcileOpenLibertyPause) resumePods(reqLogger logr.Logger, instance *openlibertyv1beta1.OpenLibertyPause) error {
+	for _, name := range instance.Status.PausedPods {
+		pod := &corev1.Pod{}
+		err := r.client.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: instance.Namespace}, pod)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		cmd := utils.GetServerPauseCommand("resume", instance.Spec.Targets, pod)
+		reqLogger.Info("Resuming the server", "pod", pod.Name, "cmd", cmd)
+		_, err = utils.ExecuteCommandInContainer(r.restConfig, pod.Name, pod.Namespace, utils.GetLibertyContainerName(pod), []string{"/bin/sh", "-c", cmd})
+		if err != nil {
+			return fmt.Errorf("Failed to resume the server of pod %s: %v", pod.Name, err)
+		}
+		if err := r.setServingCondition(pod, corev1.ConditionTrue, "Resumed", ""); err != nil {
+			return err
+		}
+	}
+	r.recorder.Event(instance, "Normal", "Resumed", "Resumed the servers of pods "+strings.Join(instance.Status.PausedPods, ", "))
+	return nil
+}
+
+// setServingCondition sets the condition of the readiness gate of the pod, when the pod has the gate
+func (r *ReconcileOpenLibertyPause) setServingCondition(pod *corev1.Pod, status corev1.ConditionStatus, reason, message string) error {
+	if !utils.HasServingReadinessGate(pod) || !utils.SetServingCondition(pod, status, reason, message) {
+		return nil
+	}
+	return r.client.Status().Update(context.TODO(), pod)
+}
+
+// getTargetPods returns the pod named in the spec, or the running pods of the application
+func (r *ReconcileOpenLibertyPause) getTargetPods(instance *openlibertyv1beta1.OpenLibertyPause) ([]corev1.Pod, error) {
+	if instance.Spec.PodName != "" {
+		pod := &corev1.Pod{}
+		err := r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.PodName, Namespace: instance.Namespace}, pod)
+		if err != nil {
+			return nil, err
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return nil, fmt.Errorf("Pod %s is not in running state", pod.Name)
+		}
+		return []corev1.Pod{*pod}, nil
+	}
+	if instance.Spec.ApplicationName == "" {
+		return nil, fmt.Errorf("Either podName or applicationName must be set")
+	}
+
+	pods := &corev1.PodList{}
+	err := r.client.List(context.TODO(), pods, client.InNamespace(instance.Namespace),
+		client.MatchingLabels{"app.kubernetes.io/instance": instance.Spec.ApplicationName})
+	if err != nil {
+		return nil, err
+	}
+	running := []corev1.Pod{}
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning && pods.Items[i].DeletionTimestamp == nil {
+			running = append(running, pods.Items[i])
+		}
+	}
+	if len(running) == 0 {
+		return nil, fmt.Errorf("Failed to find a running pod of application %s in namespace %s", instance.Spec.ApplicationName, instance.Namespace)
+	}
+	sort.Slice(running, func(i, j int) bool {
+		return running[i].Name < running[j].Name
+	})
+	return running, nil
+}
+
+func (r *ReconcileOpenLibertyPause) addFinalizer(reqLogger logr.Logger, olp *openlibertyv1beta1.OpenLibertyPause) error {
+	reqLogger.Info("Adding Finalizer for OpenLibertyPause")
+	olp.SetFinalizers(append(olp.GetFinalizers(), pauseFinalizer))
+
+	// Update CR
+	err := r.client.Update(context.TODO(), olp)
+	if err != nil {
+		reqLogger.Error(err, "Failed to update OpenLibertyPause with finalizer")
+		return err
+	}
+
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(list []string, s string) []string {
+	for i, v := range list {
+		if v == s {
+			list = append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
+}
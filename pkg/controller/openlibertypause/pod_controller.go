@@ -0,0 +1,87 @@
+package openlibertypause
+
+import (
+	"context"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// addPodController adds a controller setting the readiness gate condition of the new pods, which would otherwise
+// never become ready
+func addPodController(mgr manager.Manager, r reconcile.Reconciler) error {
+	c, err := controller.New("openlibertypause-pod-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	needsCondition := func(obj interface{}) bool {
+		pod, ok := obj.(*corev1.Pod)
+		return ok && utils.HasServingReadinessGate(pod) && utils.GetServingCondition(pod) == nil
+	}
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return needsCondition(e.ObjectNew)
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return needsCondition(e.Object)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return needsCondition(e.Object)
+		},
+	}
+
+	return c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestForObject{}, pred)
+}
+
+// blank assignment to verify that ReconcilePausePod implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcilePausePod{}
+
+// ReconcilePausePod sets the readiness gate condition of the pods that have the gate but no condition yet
+type ReconcilePausePod struct {
+	client client.Client
+}
+
+// Reconcile reports the server of the pod as serving, unless an active OpenLibertyPause paused it
+func (r *ReconcilePausePod) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	pod := &corev1.Pod{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, pod)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !utils.HasServingReadinessGate(pod) || utils.GetServingCondition(pod) != nil {
+		return reconcile.Result{}, nil
+	}
+
+	pauses := &openlibertyv1beta1.OpenLibertyPauseList{}
+	if err := r.client.List(context.TODO(), pauses, client.InNamespace(pod.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+	for i := range pauses.Items {
+		olp := &pauses.Items[i]
+		oc := openlibertyv1beta1.GetOperationCondtion(olp.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeEnabled)
+		if oc != nil && oc.Status == corev1.ConditionTrue && olp.DeletionTimestamp == nil && contains(olp.Status.PausedPods, pod.Name) {
+			utils.SetServingCondition(pod, corev1.ConditionFalse, "Paused", "The server was paused by OpenLibertyPause "+olp.Name)
+			return reconcile.Result{}, r.client.Status().Update(context.TODO(), pod)
+		}
+	}
+
+	utils.SetServingCondition(pod, corev1.ConditionTrue, "Serving", "")
+	return reconcile.Result{}, r.client.Status().Update(context.TODO(), pod)
+}
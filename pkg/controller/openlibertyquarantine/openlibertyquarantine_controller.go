@@ -0,0 +1,255 @@
+package openlibertyquarantine
+
+import (
+	"context"
+	"os"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const defaultDuration = time.Hour
+
+var log = logf.Log.WithName("controller_openlibertyquarantine")
+
+// Add creates a new OpenLibertyQuarantine Controller and adds it to the Manager. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func Add(mgr manager.Manager) error {
+	return add(mgr, newReconciler(mgr))
+}
+
+// newReconciler returns a new reconcile.Reconciler
+func newReconciler(mgr manager.Manager) reconcile.Reconciler {
+	return &ReconcileOpenLibertyQuarantine{client: mgr.GetClient(), scheme: mgr.GetScheme(), recorder: mgr.GetEventRecorderFor("open-liberty-operator")}
+}
+
+// add adds a new Controller to mgr with r as the reconcile.Reconciler
+func add(mgr manager.Manager, r reconcile.Reconciler) error {
+	// Create a new controller
+	c, err := controller.New("openlibertyquarantine-controller", mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return err
+	}
+
+	watchNamespaces, err := utils.GetWatchNamespaces()
+	if err != nil {
+		log.Error(err, "Failed to get watch namespace")
+		os.Exit(1)
+	}
+
+	watchNamespacesMap := make(map[string]bool)
+	for _, ns := range watchNamespaces {
+		watchNamespacesMap[ns] = true
+	}
+	isClusterWide := len(watchNamespacesMap) == 1 && watchNamespacesMap[""]
+
+	log.V(1).Info("Adding a new controller", "watchNamespaces", watchNamespaces, "isClusterWide", isClusterWide)
+
+	pred := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Ignore updates to CR status in which case metadata.Generation does not change
+			return e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() && (isClusterWide || watchNamespacesMap[e.MetaOld.GetNamespace()])
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return isClusterWide || watchNamespacesMap[e.Meta.GetNamespace()]
+		},
+	}
+
+	// Watch for changes to primary resource OpenLibertyQuarantine
+	err = c.Watch(&source.Kind{Type: &openlibertyv1beta1.OpenLibertyQuarantine{}}, &handler.EnqueueRequestForObject{}, pred)
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to the quarantined pods, to report when they are deleted
+	err = c.Watch(&source.Kind{Type: &corev1.Pod{}}, &handler.EnqueueRequestForOwner{
+		IsController: true,
+		OwnerType:    &openlibertyv1beta1.OpenLibertyQuarantine{},
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blank assignment to verify that ReconcileOpenLibertyQuarantine implements reconcile.Reconciler
+var _ reconcile.Reconciler = &ReconcileOpenLibertyQuarantine{}
+
+// ReconcileOpenLibertyQuarantine reconciles a OpenLibertyQuarantine object
+type ReconcileOpenLibertyQuarantine struct {
+	// This client, initialized using mgr.Client() above, is a split client
+	// that reads objects from the cache and writes to the apiserver
+	client   client.Client
+	scheme   *runtime.Scheme
+	recorder record.EventRecorder
+}
+
+// Reconcile reads that state of the cluster for a OpenLibertyQuarantine object and makes changes based on the state read
+// and what is in the OpenLibertyQuarantine.Spec
+// Note:
+// The Controller will requeue the Request to be processed again if the returned error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (r *ReconcileOpenLibertyQuarantine) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling OpenLibertyQuarantine")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyQuarantine", result, reason, time.Since(start))
+	}()
+
+	// Fetch the OpenLibertyQuarantine instance
+	instance := &openlibertyv1beta1.OpenLibertyQuarantine{}
+	err := r.client.Get(context.TODO(), request.NamespacedName, instance)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	//do not reconcile if the quarantined pod was deleted
+	oc := openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeCompleted)
+	if oc != nil && oc.Status == corev1.ConditionTrue {
+		return reconcile.Result{}, nil
+	}
+
+	oc = openlibertyv1beta1.GetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusConditionTypeStarted)
+	if oc == nil || oc.Status != corev1.ConditionTrue {
+		if err := r.startQuarantine(instance); err != nil {
+			message := "Failed to quarantine pod " + instance.Spec.PodName + ": " + err.Error()
+			log.Error(err, message)
+			r.recorder.Event(instance, "Warning", "ProcessingError", message)
+			c := openlibertyv1beta1.OperationStatusCondition{
+				Type:    openlibertyv1beta1.OperationStatusConditionTypeStarted,
+				Status:  corev1.ConditionFalse,
+				Reason:  "Error",
+				Message: err.Error(),
+			}
+			instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, c)
+			r.client.Status().Update(context.TODO(), instance)
+			result, reason = utils.MetricsResultError, "StartFailed"
+			return reconcile.Result{}, nil
+		}
+		// The quarantined pod is read again once the cache has its new owner
+		return reconcile.Result{RequeueAfter: time.Until(instance.Status.ExpirationTime.Time)}, nil
+	}
+
+	pod := &corev1.Pod{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.PodName, Namespace: instance.Namespace}, pod)
+	if err != nil && !errors.IsNotFound(err) {
+		result, reason = utils.MetricsResultError, "GetFailed"
+		return reconcile.Result{}, err
+	}
+
+	remaining := time.Until(instance.Status.ExpirationTime.Time)
+	if remaining > 0 && err == nil {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	completedReason, message := "Expired", "The quarantined pod was deleted after "+getDuration(instance).String()
+	if remaining > 0 {
+		completedReason, message = "PodDeleted", "The quarantined pod was deleted"
+	} else if err == nil {
+		if err := r.client.Delete(context.TODO(), pod); err != nil && !errors.IsNotFound(err) {
+			result, reason = utils.MetricsResultError, "DeleteFailed"
+			return reconcile.Result{}, err
+		}
+	}
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeEnabled,
+		Status: corev1.ConditionFalse,
+		Reason: completedReason,
+	})
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:    openlibertyv1beta1.OperationStatusConditionTypeCompleted,
+		Status:  corev1.ConditionTrue,
+		Reason:  completedReason,
+		Message: message,
+	})
+	err = r.client.Status().Update(context.TODO(), instance)
+	if err != nil {
+		result, reason = utils.MetricsResultError, "StatusUpdateFailed"
+	}
+	return reconcile.Result{}, err
+}
+
+// startQuarantine relabels the pod, requests the dump and sets the Started and Enabled conditions
+func (r *ReconcileOpenLibertyQuarantine) startQuarantine(instance *openlibertyv1beta1.OpenLibertyQuarantine) error {
+	pod := &corev1.Pod{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: instance.Spec.PodName, Namespace: instance.Namespace}, pod)
+	if err != nil {
+		return err
+	}
+
+	owner := metav1.NewControllerRef(instance, openlibertyv1beta1.SchemeGroupVersion.WithKind("OpenLibertyQuarantine"))
+	if err := utils.CustomizeQuarantinedPod(pod, instance.Name, *owner); err != nil {
+		return err
+	}
+	if err := r.client.Update(context.TODO(), pod); err != nil {
+		return err
+	}
+
+	if instance.Spec.Dump != nil {
+		dump := &openlibertyv1beta1.OpenLibertyDump{
+			ObjectMeta: metav1.ObjectMeta{Name: instance.Name + "-dump", Namespace: instance.Namespace},
+			Spec:       openlibertyv1beta1.OpenLibertyDumpSpec{PodName: pod.Name, Include: instance.Spec.Dump.Include},
+		}
+		if err := controllerutil.SetControllerReference(instance, dump, r.scheme); err != nil {
+			return err
+		}
+		if err := r.client.Create(context.TODO(), dump); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		instance.Status.DumpName = dump.Name
+	}
+
+	instance.Status.OperatedResource = openlibertyv1beta1.OperatedResource{ResourceType: "Pod", ResourceName: pod.Name}
+	instance.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(getDuration(instance))}
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeStarted,
+		Status: corev1.ConditionTrue,
+	})
+	instance.Status.Conditions = openlibertyv1beta1.SetOperationCondtion(instance.Status.Conditions, openlibertyv1beta1.OperationStatusCondition{
+		Type:   openlibertyv1beta1.OperationStatusConditionTypeEnabled,
+		Status: corev1.ConditionTrue,
+	})
+	r.recorder.Event(instance, "Normal", "Quarantined", "Took pod "+pod.Name+" out of its application")
+	return r.client.Status().Update(context.TODO(), instance)
+}
+
+func getDuration(instance *openlibertyv1beta1.OpenLibertyQuarantine) time.Duration {
+	if d, err := time.ParseDuration(instance.Spec.Duration); err == nil {
+		return d
+	}
+	return defaultDuration
+}
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"time"
 
-	autils "github.com/appsody/appsody-operator/pkg/utils"
 	"github.com/go-logr/logr"
 
 	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
@@ -50,7 +49,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 		return err
 	}
 
-	watchNamespaces, err := autils.GetWatchNamespaces()
+	watchNamespaces, err := utils.GetWatchNamespaces()
 	if err != nil {
 		log.Error(err, "Failed to get watch namespace")
 		os.Exit(1)
@@ -114,6 +113,11 @@ const serviceabilityDir = "/serviceability"
 func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconcile.Result, error) {
 	reqLogger := log.WithValues("Namespace", request.Namespace, "Name", request.Name)
 	reqLogger.Info("Reconciling OpenLibertyTrace")
+	start := time.Now()
+	result, reason := utils.MetricsResultSuccess, ""
+	defer func() {
+		utils.RecordReconcile("OpenLibertyTrace", result, reason, time.Since(start))
+	}()
 
 	// Fetch the OpenLibertyTrace instance
 	instance := &openlibertyv1beta1.OpenLibertyTrace{}
@@ -128,6 +132,7 @@ func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconc
 		}
 		reqLogger.Info("Error reading the object - requeue the request.")
 		// Error reading the object - requeue the request.
+		result, reason = utils.MetricsResultError, "GetFailed"
 		return reconcile.Result{}, err
 	}
 
@@ -147,6 +152,7 @@ func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconc
 			// Run finalization logic for traceFinalizer. If the finalization logic fails, don't remove the
 			// finalizer so that we can retry during the next reconciliation.
 			if err := r.finalizeOpenLibertyTrace(reqLogger, instance, prevTraceEnabled, prevPodName, podNamespace); err != nil {
+				result, reason = utils.MetricsResultError, "FinalizerFailed"
 				return reconcile.Result{}, err
 			}
 
@@ -154,6 +160,7 @@ func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconc
 			instance.SetFinalizers(remove(instance.GetFinalizers(), traceFinalizer))
 			err := r.client.Update(context.TODO(), instance)
 			if err != nil {
+				result, reason = utils.MetricsResultError, "FinalizerFailed"
 				return reconcile.Result{}, err
 			}
 		}
@@ -163,6 +170,7 @@ func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconc
 	// Add finalizer for this CR
 	if !contains(instance.GetFinalizers(), traceFinalizer) {
 		if err := r.addFinalizer(reqLogger, instance); err != nil {
+			result, reason = utils.MetricsResultError, "FinalizerFailed"
 			return reconcile.Result{}, err
 		}
 	}
@@ -172,19 +180,23 @@ func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconc
 		r.disableTraceOnPrevPod(reqLogger, prevPodName, podNamespace)
 	}
 
-	err = r.client.Get(context.TODO(), types.NamespacedName{Name: podName, Namespace: podNamespace}, &corev1.Pod{})
+	pod := &corev1.Pod{}
+	err = r.client.Get(context.TODO(), types.NamespacedName{Name: podName, Namespace: podNamespace}, pod)
 	if err != nil && errors.IsNotFound(err) {
 		//Pod is not found. Return and don't requeue
 		reqLogger.Error(err, "Pod "+podName+" was not found in namespace "+podNamespace)
+		result, reason = utils.MetricsResultError, "PodNotFound"
 		return r.UpdateStatus(err, openlibertyv1beta1.OperationStatusConditionTypeEnabled, *instance, corev1.ConditionFalse, podName, podChanged)
 	}
 
 	if instance.Spec.Disable != nil && *instance.Spec.Disable {
 		//Disable trace if trace was previously enabled on the same pod
 		if !podChanged && prevTraceEnabled == corev1.ConditionTrue {
-			_, err = utils.ExecuteCommandInContainer(r.restConfig, podName, podNamespace, "app", []string{"/bin/sh", "-c", "rm -f " + traceConfigFile})
+			_, err = utils.ExecuteCommandInContainer(r.restConfig, podName, podNamespace, utils.GetLibertyContainerName(pod), []string{"/bin/sh", "-c", "rm -f " + traceConfigFile})
+			utils.RecordTraceOperation(utils.TraceOperationDisable, err)
 			if err != nil {
 				reqLogger.Error(err, "Encountered error while disabling trace for pod "+podName+" in namespace "+podNamespace)
+				result, reason = utils.MetricsResultError, "TraceDisableFailed"
 				return r.UpdateStatus(err, openlibertyv1beta1.OperationStatusConditionTypeEnabled, *instance, corev1.ConditionTrue, podName, podChanged)
 			}
 			reqLogger.Info("Disabled trace for pod " + podName + " in namespace " + podNamespace)
@@ -201,9 +213,11 @@ func (r *ReconcileOpenLibertyTrace) Reconcile(request reconcile.Request) (reconc
 		}
 		traceConfig += "/></server>"
 
-		_, err = utils.ExecuteCommandInContainer(r.restConfig, podName, podNamespace, "app", []string{"/bin/sh", "-c", "mkdir -p " + traceOutputDir + " && echo '" + traceConfig + "' > " + traceConfigFile})
+		_, err = utils.ExecuteCommandInContainer(r.restConfig, podName, podNamespace, utils.GetLibertyContainerName(pod), []string{"/bin/sh", "-c", "mkdir -p " + traceOutputDir + " && echo '" + traceConfig + "' > " + traceConfigFile})
+		utils.RecordTraceOperation(utils.TraceOperationEnable, err)
 		if err != nil {
 			reqLogger.Error(err, "Encountered error while setting up trace for pod "+podName+" in namespace "+podNamespace)
+			result, reason = utils.MetricsResultError, "TraceEnableFailed"
 			return r.UpdateStatus(err, openlibertyv1beta1.OperationStatusConditionTypeEnabled, *instance, corev1.ConditionFalse, podName, podChanged)
 		}
 
@@ -263,13 +277,15 @@ func (r *ReconcileOpenLibertyTrace) UpdateStatus(issue error, conditionType open
 }
 
 func (r *ReconcileOpenLibertyTrace) disableTraceOnPrevPod(reqLogger logr.Logger, prevPodName string, podNamespace string) {
-	err := r.client.Get(context.TODO(), types.NamespacedName{Name: prevPodName, Namespace: podNamespace}, &corev1.Pod{})
+	prevPod := &corev1.Pod{}
+	err := r.client.Get(context.TODO(), types.NamespacedName{Name: prevPodName, Namespace: podNamespace}, prevPod)
 	if err != nil && errors.IsNotFound(err) {
 		//Previous Pod is not found. No-op
 		reqLogger.Info("Previous pod " + prevPodName + " was not found in namespace " + podNamespace)
 	} else {
 		//Stop tracing on previous Pod
-		_, err = utils.ExecuteCommandInContainer(r.restConfig, prevPodName, podNamespace, "app", []string{"/bin/sh", "-c", "rm -f " + traceConfigFile})
+		_, err = utils.ExecuteCommandInContainer(r.restConfig, prevPodName, podNamespace, utils.GetLibertyContainerName(prevPod), []string{"/bin/sh", "-c", "rm -f " + traceConfigFile})
+		utils.RecordTraceOperation(utils.TraceOperationDisable, err)
 		if err == nil {
 			reqLogger.Info("Disabled trace on previous pod " + prevPodName + " in namespace " + podNamespace)
 		} else {
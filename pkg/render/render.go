@@ -0,0 +1,263 @@
+// Package render produces the resources of OpenLibertyApplications without a cluster, by running the
+// reconciliation against an in-memory client.
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/OpenLiberty/open-liberty-operator/pkg/apis"
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/OpenLiberty/open-liberty-operator/pkg/controller/openliberty"
+	lutils "github.com/OpenLiberty/open-liberty-operator/pkg/utils"
+	"github.com/appsody/appsody-operator/pkg/common"
+	autils "github.com/appsody/appsody-operator/pkg/utils"
+	prometheusv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+// Options selects the optional APIs that are assumed to be available in the cluster
+type Options struct {
+	// Namespace of the resources that don't set one
+	Namespace string
+	// Route assumes that OpenShift Routes are available
+	Route bool
+	// Knative assumes that Knative Serving is available
+	Knative bool
+	// Prometheus assumes that the Prometheus Operator is available
+	Prometheus bool
+	// ResolveImageDigest contacts the registry to resolve the digest of the application image when the application
+	// sets imageDigest. Otherwise, the pods use the tag of the image.
+	ResolveImageDigest bool
+}
+
+// The kinds of resources that are rendered, in the order they are printed
+var renderedLists = []runtime.Object{
+	&corev1.ServiceAccountList{},
+	&corev1.SecretList{},
+	&corev1.ConfigMapList{},
+	&corev1.PersistentVolumeClaimList{},
+	&corev1.ServiceList{},
+	&appsv1.DeploymentList{},
+	&appsv1.StatefulSetList{},
+	&autoscalingv1.HorizontalPodAutoscalerList{},
+	&batchv1.JobList{},
+	&routev1.RouteList{},
+	&servingv1alpha1.ServiceList{},
+	&prometheusv1.ServiceMonitorList{},
+}
+
+// NewScheme returns a scheme with the Kubernetes types and the types of the optional APIs
+func NewScheme() (*runtime.Scheme, error) {
+	s := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{clientgoscheme.AddToScheme, apis.AddToScheme,
+		routev1.AddToScheme, servingv1alpha1.AddToScheme, prometheusv1.AddToScheme} {
+		if err := add(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Decode reads the objects of a YAML or JSON stream holding one or more documents
+func Decode(s *runtime.Scheme, r io.Reader) ([]runtime.Object, error) {
+	decoder := serializer.NewCodecFactory(s).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	objs := []runtime.Object{}
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return objs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, obj)
+	}
+}
+
+// Render reconciles the OpenLibertyApplications among the given objects and returns the resources they produce.
+// The other objects, e.g. OpenLibertyDefaults or Secrets the applications refer to, are only read.
+func Render(s *runtime.Scheme, objs []runtime.Object, options Options) ([]runtime.Object, error) {
+	if options.Namespace == "" {
+		options.Namespace = corev1.NamespaceDefault
+	}
+
+	inputs := map[string]bool{}
+	apps := []*openlibertyv1beta1.OpenLibertyApplication{}
+	for _, obj := range objs {
+		m, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		if m.GetNamespace() == "" {
+			m.SetNamespace(options.Namespace)
+		}
+		if app, ok := obj.(*openlibertyv1beta1.OpenLibertyApplication); ok {
+			if !options.ResolveImageDigest {
+				app.Spec.ImageDigest = nil
+			}
+			apps = append(apps, app)
+		}
+		inputs[getKey(lutils.GetResourceReference(obj), m.GetNamespace())] = true
+	}
+	if len(apps) == 0 {
+		return nil, fmt.Errorf("No OpenLibertyApplication found")
+	}
+
+	cl := fakeclient.NewFakeClientWithScheme(s, objs...)
+	r := openliberty.NewReconcileOpenLiberty(autils.NewReconcilerBase(cl, s, &rest.Config{}, &record.FakeRecorder{}))
+	r.SetDiscoveryClient(newDiscoveryClient(options))
+
+	namespaces := []string{}
+	seen := map[string]bool{}
+	for _, app := range apps {
+		if !seen[app.Namespace] {
+			seen[app.Namespace] = true
+			namespaces = append(namespaces, app.Namespace)
+		}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: app.Name, Namespace: app.Namespace}}
+		if _, err := r.Reconcile(req); err != nil {
+			return nil, fmt.Errorf("Failed to render OpenLibertyApplication %s: %v", app.Name, err)
+		}
+
+		reconciled := &openlibertyv1beta1.OpenLibertyApplication{}
+		if err := cl.Get(context.TODO(), req.NamespacedName, reconciled); err != nil {
+			return nil, err
+		}
+		if c := reconciled.Status.GetCondition(common.StatusConditionTypeReconciled); c != nil && c.GetStatus() != corev1.ConditionTrue {
+			return nil, fmt.Errorf("Failed to render OpenLibertyApplication %s: %s", app.Name, c.GetMessage())
+		}
+	}
+
+	sort.Strings(namespaces)
+	rendered := []runtime.Object{}
+	for _, list := range renderedLists {
+		for _, ns := range namespaces {
+			list = list.DeepCopyObject()
+			if err := cl.List(context.TODO(), list, client.InNamespace(ns)); err != nil {
+				return nil, err
+			}
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return nil, err
+			}
+			sort.SliceStable(items, func(i, j int) bool {
+				mi, _ := meta.Accessor(items[i])
+				mj, _ := meta.Accessor(items[j])
+				return mi.GetName() < mj.GetName()
+			})
+			for _, item := range items {
+				m, _ := meta.Accessor(item)
+				ref := lutils.GetResourceReference(item)
+				if inputs[getKey(ref, m.GetNamespace())] {
+					continue
+				}
+				item.GetObjectKind().SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+				rendered = append(rendered, item)
+			}
+		}
+	}
+	return rendered, nil
+}
+
+// Marshal returns the resources as YAML documents, without the fields set by the cluster
+func Marshal(objs []runtime.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, obj := range objs {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		delete(u, "status")
+		if m, ok := u["metadata"].(map[string]interface{}); ok {
+			for _, f := range []string{"creationTimestamp", "resourceVersion", "uid", "ownerReferences"} {
+				delete(m, f)
+			}
+		}
+		data, err := yaml.Marshal(u)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
+
+func getKey(ref openlibertyv1beta1.OpenLibertyApplicationResourceReference, namespace string) string {
+	return ref.APIVersion + "/" + ref.Kind + "/" + namespace + "/" + ref.Name
+}
+
+// discoveryClient reports the optional APIs selected in the options as available
+type discoveryClient struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func newDiscoveryClient(options Options) *discoveryClient {
+	dc := &discoveryClient{&fakediscovery.FakeDiscovery{Fake: &coretesting.Fake{}}}
+	if options.Route {
+		dc.Resources = append(dc.Resources, &metav1.APIResourceList{
+			GroupVersion: routev1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{{Name: "routes", Namespaced: true, Kind: "Route"}},
+		})
+	}
+	if options.Knative {
+		dc.Resources = append(dc.Resources, &metav1.APIResourceList{
+			GroupVersion: servingv1alpha1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{{Name: "services", Namespaced: true, Kind: "Service"}},
+		})
+	}
+	if options.Prometheus {
+		dc.Resources = append(dc.Resources, &metav1.APIResourceList{
+			GroupVersion: prometheusv1.SchemeGroupVersion.String(),
+			APIResources: []metav1.APIResource{{Name: "servicemonitors", Namespaced: true, Kind: "ServiceMonitor"}},
+		})
+	}
+	return dc
+}
+
+// ServerResourcesForGroupVersion returns a NotFound error for the APIs that aren't available, as the API server does
+func (dc *discoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	for _, resources := range dc.Resources {
+		if resources.GroupVersion == groupVersion {
+			return resources, nil
+		}
+	}
+	gv, _ := schema.ParseGroupVersion(groupVersion)
+	return nil, apierrors.NewNotFound(gv.WithResource("").GroupResource(), "")
+}
@@ -0,0 +1,70 @@
+package render
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		golden  string
+		options Options
+	}{
+		{"Kubernetes", "app.golden.yaml", Options{}},
+		{"OpenShift with Prometheus", "app-openshift.golden.yaml", Options{Route: true, Prometheus: true}},
+	}
+
+	s, err := NewScheme()
+	if err != nil {
+		t.Fatalf("NewScheme: (%v)", err)
+	}
+	for _, tt := range tests {
+		f, err := os.Open(filepath.Join("testdata", "app.yaml"))
+		if err != nil {
+			t.Fatalf("%s: (%v)", tt.name, err)
+		}
+		objs, err := Decode(s, f)
+		f.Close()
+		if err != nil {
+			t.Fatalf("%s: Decode (%v)", tt.name, err)
+		}
+		rendered, err := Render(s, objs, tt.options)
+		if err != nil {
+			t.Fatalf("%s: Render (%v)", tt.name, err)
+		}
+		actual, err := Marshal(rendered)
+		if err != nil {
+			t.Fatalf("%s: Marshal (%v)", tt.name, err)
+		}
+
+		golden := filepath.Join("testdata", tt.golden)
+		if *update {
+			if err := ioutil.WriteFile(golden, actual, 0644); err != nil {
+				t.Fatalf("%s: (%v)", tt.name, err)
+			}
+		}
+		expected, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatalf("%s: (%v)", tt.name, err)
+		}
+		if string(expected) != string(actual) {
+			t.Errorf("%s: rendered resources differ from %s, run the test with -update to review the changes:\n%s", tt.name, golden, actual)
+		}
+	}
+}
+
+func TestRenderWithoutApplication(t *testing.T) {
+	s, err := NewScheme()
+	if err != nil {
+		t.Fatalf("NewScheme: (%v)", err)
+	}
+	if _, err := Render(s, nil, Options{}); err == nil {
+		t.Errorf("expected an error when no OpenLibertyApplication is given")
+	}
+}
@@ -0,0 +1,129 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ArtifactDownloaderImageEnvVar is the environment variable of the operator overriding the image of the
+	// init container downloading the artifacts. The image must provide sh, curl, sha1sum and sha256sum.
+	ArtifactDownloaderImageEnvVar = "ARTIFACT_DOWNLOADER_IMAGE"
+
+	defaultArtifactDownloaderImage = "registry.access.redhat.com/ubi8/ubi-minimal:latest"
+	defaultMavenRepository         = "https://repo1.maven.org/maven2"
+	artifactsContainerName         = "artifacts"
+	artifactsVolumeName            = "artifacts"
+	artifactsMountPath             = "/artifacts"
+	serverConfigPath               = "/config"
+)
+
+// GetArtifactDownloaderImage returns the image of the init container downloading the artifacts
+func GetArtifactDownloaderImage() string {
+	if image := os.Getenv(ArtifactDownloaderImageEnvVar); image != "" {
+		return image
+	}
+	return defaultArtifactDownloaderImage
+}
+
+// GetArtifactURL returns the URL the artifact is downloaded from
+func GetArtifactURL(a *openlibertyv1beta1.OpenLibertyApplicationArtifact) string {
+	if a.Maven == nil {
+		return a.URL
+	}
+	m := a.Maven
+	repository := defaultMavenRepository
+	if m.Repository != "" {
+		repository = strings.TrimSuffix(m.Repository, "/")
+	}
+	file := m.ArtifactID + "-" + m.Version
+	if m.Classifier != "" {
+		file += "-" + m.Classifier
+	}
+	ext := m.Type
+	if ext == "" {
+		ext = "war"
+	}
+	return strings.Join([]string{repository, strings.Replace(m.GroupID, ".", "/", -1), m.ArtifactID, m.Version, file + "." + ext}, "/")
+}
+
+// CustomizeArtifacts adds an init container downloading the artifacts into a volume shared with the application
+// container, where each artifact is mounted in its directory of the server configuration. The artifacts that
+// come with the image are left in place. A change to the artifacts changes the pod template, which rolls the pods.
+func CustomizeArtifacts(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	// Init containers set by the user are kept across reconciles, so the one of a previous reconcile is replaced
+	initContainers := []corev1.Container{}
+	for _, c := range pts.Spec.InitContainers {
+		if c.Name != artifactsContainerName {
+			initContainers = append(initContainers, c)
+		}
+	}
+	pts.Spec.InitContainers = initContainers
+
+	artifacts := la.GetArtifacts()
+	if len(artifacts) == 0 {
+		if len(pts.Spec.InitContainers) == 0 {
+			pts.Spec.InitContainers = nil
+		}
+		return
+	}
+
+	container := corev1.Container{
+		Name:         artifactsContainerName,
+		Image:        GetArtifactDownloaderImage(),
+		Command:      []string{"/bin/sh", "-c"},
+		Args:         []string{GetArtifactsScript(artifacts)},
+		Env:          []corev1.EnvVar{{Name: "ARTIFACTS_DIR", Value: artifactsMountPath}},
+		VolumeMounts: []corev1.VolumeMount{{Name: artifactsVolumeName, MountPath: artifactsMountPath}},
+	}
+	for i := range artifacts {
+		a := &artifacts[i]
+		if a.CredentialsSecret != "" {
+			container.Env = append(container.Env,
+				getSecretKeyEnv(fmt.Sprintf("ARTIFACT_%d_USERNAME", i), "username", a.CredentialsSecret),
+				getSecretKeyEnv(fmt.Sprintf("ARTIFACT_%d_PASSWORD", i), "password", a.CredentialsSecret))
+		}
+		pts.Spec.Containers[0].VolumeMounts = append(pts.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      artifactsVolumeName,
+			MountPath: serverConfigPath + "/" + string(a.GetDirectory()) + "/" + a.Name,
+			SubPath:   string(a.GetDirectory()) + "/" + a.Name,
+			ReadOnly:  true,
+		})
+	}
+	pts.Spec.InitContainers = append(pts.Spec.InitContainers, container)
+	pts.Spec.Volumes = append(pts.Spec.Volumes, corev1.Volume{
+		Name:         artifactsVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+}
+
+// GetArtifactsScript returns the shell script downloading the artifacts into $ARTIFACTS_DIR and verifying their
+// checksums. The script fails on the first artifact that can't be downloaded or doesn't match its checksum.
+func GetArtifactsScript(artifacts []openlibertyv1beta1.OpenLibertyApplicationArtifact) string {
+	lines := []string{"set -e"}
+	for i := range artifacts {
+		a := &artifacts[i]
+		dir := `"$ARTIFACTS_DIR"/` + string(a.GetDirectory())
+		file := dir + "/" + shellQuote(a.Name)
+		url := shellQuote(GetArtifactURL(a))
+		curl := "curl -fsSL --retry 3"
+		if a.CredentialsSecret != "" {
+			curl += fmt.Sprintf(` -u "$ARTIFACT_%d_USERNAME:$ARTIFACT_%d_PASSWORD"`, i, i)
+		}
+
+		lines = append(lines,
+			"echo "+shellQuote("Downloading "+a.Name+" from "+GetArtifactURL(a)),
+			"mkdir -p "+dir,
+			curl+" -o "+file+" "+url)
+		if a.SHA256 != "" {
+			lines = append(lines, fmt.Sprintf(`echo "%s  "%s | sha256sum -c -`, strings.ToLower(a.SHA256), file))
+		} else if a.Maven != nil {
+			lines = append(lines, fmt.Sprintf(`echo "$(%s %s | cut -c1-40)  "%s | sha1sum -c -`, curl, shellQuote(GetArtifactURL(a)+".sha1"), file))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
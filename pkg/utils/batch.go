@@ -0,0 +1,283 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CronBatchJobLabel is set on the Jobs of an OpenLibertyCronBatchJob to its name
+	CronBatchJobLabel = "openliberty.io/cron-batch-job"
+
+	defaultBatchManagerPort = 9443
+	batchOutputFile         = "/tmp/batch.log"
+	batchLogFileName        = "batch.log"
+	// batchManager exits with this code when the job it waited for completed
+	batchManagerCompletedCode = 35
+)
+
+var batchStepPattern = regexp.MustCompile(`[Ss]tep[: ]+([^\s,]+?)[,.]?\s.*batch status[: ]+(\w+).*exit status[: ]+([\w-]+)`)
+
+// CustomizeBatchJob runs the batch job in a Kubernetes Job. The container starts the server, submits the job
+// with batchManager, waits for it to finish and reports the execution through its termination message.
+func CustomizeBatchJob(jobSpec *batchv1.JobSpec, spec *openlibertyv1beta1.OpenLibertyBatchJobSpec, labels map[string]string, namespace string) {
+	backoffLimit := int32(0)
+	if spec.BackoffLimit != nil {
+		backoffLimit = *spec.BackoffLimit
+	}
+	jobSpec.BackoffLimit = &backoffLimit
+	jobSpec.ActiveDeadlineSeconds = spec.ActiveDeadlineSeconds
+
+	pts := &jobSpec.Template
+	pts.Labels = labels
+	pts.Spec.RestartPolicy = corev1.RestartPolicyNever
+	pts.Spec.ServiceAccountName = ""
+	if spec.ServiceAccountName != nil {
+		pts.Spec.ServiceAccountName = *spec.ServiceAccountName
+	}
+	pts.Spec.ImagePullSecrets = nil
+	if spec.PullSecret != nil {
+		pts.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: *spec.PullSecret}}
+	}
+
+	env := append([]corev1.EnvVar{}, spec.Env...)
+	env = append(env, getSecretKeyEnv("BATCH_USER", "username", spec.CredentialsSecret),
+		getSecretKeyEnv("BATCH_PASSWORD", "password", spec.CredentialsSecret))
+
+	container := corev1.Container{
+		Name:                     LibertyContainerName,
+		Image:                    spec.ApplicationImage,
+		Command:                  []string{"/bin/sh", "-c"},
+		Args:                     []string{GetBatchJobScript(spec, namespace)},
+		Env:                      env,
+		EnvFrom:                  spec.EnvFrom,
+		VolumeMounts:             spec.VolumeMounts,
+		TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+	}
+	if spec.PullPolicy != nil {
+		container.ImagePullPolicy = *spec.PullPolicy
+	}
+	if spec.ResourceConstraints != nil {
+		container.Resources = *spec.ResourceConstraints
+	}
+	pts.Spec.Volumes = spec.Volumes
+	if spec.Serviceability != nil {
+		container.VolumeMounts = append(append([]corev1.VolumeMount{}, spec.VolumeMounts...),
+			corev1.VolumeMount{Name: "serviceability", MountPath: serviceabilityMountPath})
+		pts.Spec.Volumes = append(append([]corev1.Volume{}, spec.Volumes...), corev1.Volume{
+			Name: "serviceability",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: spec.Serviceability.VolumeClaimName},
+			},
+		})
+	}
+	pts.Spec.Containers = []corev1.Container{container}
+}
+
+// CustomizeCronBatchJob schedules the batch job with a CronJob
+func CustomizeCronBatchJob(cronJob *batchv1beta1.CronJob, cbj *openlibertyv1beta1.OpenLibertyCronBatchJob) {
+	cronJob.Labels = GetBatchJobLabels(cbj.Name)
+	cronJob.Spec.Schedule = cbj.Spec.Schedule
+	cronJob.Spec.ConcurrencyPolicy = cbj.Spec.ConcurrencyPolicy
+	cronJob.Spec.Suspend = cbj.Spec.Suspend
+	cronJob.Spec.SuccessfulJobsHistoryLimit = cbj.Spec.SuccessfulJobsHistoryLimit
+	cronJob.Spec.FailedJobsHistoryLimit = cbj.Spec.FailedJobsHistoryLimit
+
+	labels := GetBatchJobLabels(cbj.Name)
+	labels[CronBatchJobLabel] = cbj.Name
+	cronJob.Spec.JobTemplate.Labels = labels
+	CustomizeBatchJob(&cronJob.Spec.JobTemplate.Spec, &cbj.Spec.JobTemplate, labels, cbj.Namespace)
+}
+
+// GetBatchJobLabels returns the labels of the Jobs running a batch job
+func GetBatchJobLabels(name string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       name,
+		"app.kubernetes.io/component":  "batch",
+		"app.kubernetes.io/managed-by": "open-liberty-operator",
+	}
+}
+
+// GetBatchJobScript returns the shell script submitting the batch job to the server of the container
+func GetBatchJobScript(spec *openlibertyv1beta1.OpenLibertyBatchJobSpec, namespace string) string {
+	port := int32(defaultBatchManagerPort)
+	if spec.Port != nil {
+		port = *spec.Port
+	}
+	submit := []string{"batchManager", "submit", fmt.Sprintf("--batchManager=localhost:%d", port),
+		`--user="$BATCH_USER"`, `--password="$BATCH_PASSWORD"`, "--trustSslCertificates",
+		"--applicationName=" + shellQuote(spec.ApplicationName), "--jobXMLName=" + shellQuote(spec.JobXMLName)}
+	keys := []string{}
+	for k := range spec.JobParameters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		submit = append(submit, "--jobParameter="+shellQuote(k+"="+spec.JobParameters[k]))
+	}
+	submit = append(submit, "--wait", "--pollingInterval_s=5", "--getJobLog")
+
+	lines := []string{
+		"server start || exit 1",
+		strings.Join(submit, " ") + " > " + batchOutputFile + " 2>&1",
+		"rc=$?",
+		"cat " + batchOutputFile,
+		"grep -E 'JobInstance:|JobExecution:|[Ss]tep.*batch status' " + batchOutputFile + " | tail -c 4000 > /dev/termination-log",
+	}
+	if spec.Serviceability != nil {
+		dir := serviceabilityMountPath + "/" + namespace + "/$(hostname)"
+		lines = append(lines, "mkdir -p "+dir+" && cp "+batchOutputFile+" "+dir+"/"+batchLogFileName)
+	}
+	lines = append(lines,
+		"server stop",
+		fmt.Sprintf("[ $rc -eq %d ] && rc=0", batchManagerCompletedCode),
+		"exit $rc",
+	)
+	return strings.Join(lines, "\n")
+}
+
+// GetBatchJobLogFile returns the path of the job log of a pod in the serviceability volume
+func GetBatchJobLogFile(namespace, podName string) string {
+	return serviceabilityMountPath + "/" + namespace + "/" + podName + "/" + batchLogFileName
+}
+
+// IsJobFinished returns whether the Job completed or failed, and the message of the failure
+func IsJobFinished(job *batchv1.Job) (bool, bool, string) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		if c.Type == batchv1.JobComplete {
+			return true, true, ""
+		}
+		if c.Type == batchv1.JobFailed {
+			return true, false, c.Message
+		}
+	}
+	return false, false, ""
+}
+
+// GetBatchJobPod returns the most recent terminated pod of a Job, or nil
+func GetBatchJobPod(pods []corev1.Pod) *corev1.Pod {
+	var last *corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if last == nil || last.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			last = pod
+		}
+	}
+	return last
+}
+
+// GetBatchJobExecution returns the execution reported by a terminated pod, or nil
+func GetBatchJobExecution(pod *corev1.Pod) *openlibertyv1beta1.OpenLibertyBatchJobExecution {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == LibertyContainerName && cs.State.Terminated != nil && cs.State.Terminated.Message != "" {
+			return ParseBatchJobOutput(cs.State.Terminated.Message)
+		}
+	}
+	return nil
+}
+
+// ParseBatchJobOutput reads the job instance, the job execution and the steps from the output of batchManager
+func ParseBatchJobOutput(out string) *openlibertyv1beta1.OpenLibertyBatchJobExecution {
+	var execution *openlibertyv1beta1.OpenLibertyBatchJobExecution
+	get := func() *openlibertyv1beta1.OpenLibertyBatchJobExecution {
+		if execution == nil {
+			execution = &openlibertyv1beta1.OpenLibertyBatchJobExecution{}
+		}
+		return execution
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		if i := strings.Index(line, "JobExecution:"); i >= 0 {
+			je := struct {
+				InstanceID  json.Number `json:"instanceId"`
+				ExecutionID json.Number `json:"executionId"`
+				BatchStatus string      `json:"batchStatus"`
+				ExitStatus  string      `json:"exitStatus"`
+			}{}
+			if json.Unmarshal([]byte(line[i+len("JobExecution:"):]), &je) == nil {
+				e := get()
+				if id, err := strconv.ParseInt(string(je.InstanceID), 10, 64); err == nil {
+					e.InstanceID = id
+				}
+				if id, err := strconv.ParseInt(string(je.ExecutionID), 10, 64); err == nil {
+					e.ExecutionID = id
+				}
+				e.BatchStatus, e.ExitStatus = je.BatchStatus, je.ExitStatus
+			}
+		} else if i := strings.Index(line, "JobInstance:"); i >= 0 {
+			ji := struct {
+				InstanceID json.Number `json:"instanceId"`
+			}{}
+			if json.Unmarshal([]byte(line[i+len("JobInstance:"):]), &ji) == nil {
+				if id, err := strconv.ParseInt(string(ji.InstanceID), 10, 64); err == nil {
+					get().InstanceID = id
+				}
+			}
+		} else if m := batchStepPattern.FindStringSubmatch(line); m != nil {
+			e := get()
+			step := openlibertyv1beta1.OpenLibertyBatchJobStepExecution{Name: m[1], BatchStatus: m[2], ExitStatus: m[3]}
+			found := false
+			for j := range e.Steps {
+				if e.Steps[j].Name == step.Name {
+					e.Steps[j], found = step, true
+				}
+			}
+			if !found {
+				e.Steps = append(e.Steps, step)
+			}
+		}
+	}
+	return execution
+}
+
+// GetLatestFinishedJob returns the Job that started last among the finished ones, or nil
+func GetLatestFinishedJob(jobs []batchv1.Job) *batchv1.Job {
+	var last *batchv1.Job
+	var lastStart metav1.Time
+	for i := range jobs {
+		if finished, _, _ := IsJobFinished(&jobs[i]); !finished {
+			continue
+		}
+		start := jobs[i].CreationTimestamp
+		if jobs[i].Status.StartTime != nil {
+			start = *jobs[i].Status.StartTime
+		}
+		if last == nil || lastStart.Before(&start) {
+			last, lastStart = &jobs[i], start
+		}
+	}
+	return last
+}
+
+func getSecretKeyEnv(name, key, secret string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+// shellQuote quotes a value for the shell, which doesn't expand anything between single quotes
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
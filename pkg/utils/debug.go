@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DebugLabel is set on the pods running the debug agent to the name of their OpenLibertyDebug
+	DebugLabel = "openliberty.io/debug"
+	// DefaultDebugPort is the port of the debug agent when the OpenLibertyDebug doesn't set one
+	DefaultDebugPort int32 = 7777
+)
+
+// GetDebugAgentOption returns the JVM option that loads the debug agent. The agent is bound to the loopback address,
+// as Java 8 listens on all interfaces when the address is a port only. kubectl port-forward connects to the loopback
+// address of the pod, and the port isn't exposed to the rest of the cluster.
+func GetDebugAgentOption(port int32, suspend bool) string {
+	s := "n"
+	if suspend {
+		s = "y"
+	}
+	return fmt.Sprintf("-agentlib:jdwp=transport=dt_socket,server=y,suspend=%s,address=127.0.0.1:%d", s, port)
+}
+
+// CustomizeDebugPod turns a copy of the target pod into a pod running the server with the debug agent. The pod
+// loses the labels selecting it for the Service and the Deployment or StatefulSet of the application, and its
+// probes, so that a suspended thread doesn't get the container restarted.
+func CustomizeDebugPod(pod *corev1.Pod, target *corev1.Pod, port int32, suspend bool) {
+	pod.Labels = map[string]string{}
+	for k, v := range target.Labels {
+		if k != "app.kubernetes.io/instance" && k != "pod-template-hash" && k != "controller-revision-hash" &&
+			k != "statefulset.kubernetes.io/pod-name" {
+			pod.Labels[k] = v
+		}
+	}
+	pod.Labels[DebugLabel] = pod.Name
+	pod.Annotations = map[string]string{}
+	for k, v := range target.Annotations {
+		pod.Annotations[k] = v
+	}
+
+	pod.Spec = *target.Spec.DeepCopy()
+	pod.Spec.NodeName = ""
+	pod.Spec.Hostname = ""
+	pod.Spec.Subdomain = ""
+
+	name := GetLibertyContainerName(target)
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if container.Name != name {
+			continue
+		}
+		container.LivenessProbe = nil
+		container.ReadinessProbe = nil
+		container.Ports = append(container.Ports, corev1.ContainerPort{Name: "debug", ContainerPort: port, Protocol: corev1.ProtocolTCP})
+
+		option := GetDebugAgentOption(port, suspend)
+		found := false
+		for j := range container.Env {
+			env := &container.Env[j]
+			if env.Name == "JAVA_TOOL_OPTIONS" {
+				found = true
+				env.Value = strings.TrimSpace(env.Value + " " + option)
+				env.ValueFrom = nil
+			}
+		}
+		if !found {
+			container.Env = append(container.Env, corev1.EnvVar{Name: "JAVA_TOOL_OPTIONS", Value: option})
+		}
+	}
+}
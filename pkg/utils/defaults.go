@@ -0,0 +1,120 @@
+package utils
+
+import (
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ApplyDefaults fills the values the application doesn't set from the given defaults, listed from the
+// highest to the lowest precedence. It returns the values that were taken from the defaults.
+func ApplyDefaults(la *openlibertyv1beta1.OpenLibertyApplication, defaults ...*openlibertyv1beta1.OpenLibertyDefaultsSpec) *openlibertyv1beta1.OpenLibertyDefaultsSpec {
+	spec := &la.Spec
+	applied := &openlibertyv1beta1.OpenLibertyDefaultsSpec{}
+
+	// Probes generated by the application's own probe defaults take precedence over the probes of the defaults
+	appProbes := spec.Probes != nil
+
+	for _, d := range defaults {
+		if d == nil {
+			continue
+		}
+		if spec.PullPolicy == nil && d.PullPolicy != nil {
+			spec.PullPolicy, applied.PullPolicy = d.PullPolicy, d.PullPolicy
+		}
+		if (spec.PullSecret == nil || *spec.PullSecret == "") && d.PullSecret != nil {
+			spec.PullSecret, applied.PullSecret = d.PullSecret, d.PullSecret
+		}
+		if (spec.ServiceAccountName == nil || *spec.ServiceAccountName == "") && d.ServiceAccountName != nil {
+			spec.ServiceAccountName, applied.ServiceAccountName = d.ServiceAccountName, d.ServiceAccountName
+		}
+		if d.ResourceConstraints != nil {
+			if spec.ResourceConstraints == nil {
+				spec.ResourceConstraints = &corev1.ResourceRequirements{}
+			}
+			if applied.ResourceConstraints == nil {
+				applied.ResourceConstraints = &corev1.ResourceRequirements{}
+			}
+			spec.ResourceConstraints.Requests = mergeResources(spec.ResourceConstraints.Requests, d.ResourceConstraints.Requests, &applied.ResourceConstraints.Requests)
+			spec.ResourceConstraints.Limits = mergeResources(spec.ResourceConstraints.Limits, d.ResourceConstraints.Limits, &applied.ResourceConstraints.Limits)
+		}
+		if !appProbes {
+			if spec.ReadinessProbe == nil && d.ReadinessProbe != nil {
+				spec.ReadinessProbe, applied.ReadinessProbe = d.ReadinessProbe, d.ReadinessProbe
+			}
+			if spec.LivenessProbe == nil && d.LivenessProbe != nil {
+				spec.LivenessProbe, applied.LivenessProbe = d.LivenessProbe, d.LivenessProbe
+			}
+			if spec.StartupProbe == nil && d.StartupProbe != nil {
+				spec.StartupProbe, applied.StartupProbe = d.StartupProbe, d.StartupProbe
+			}
+			if spec.Probes == nil && d.Probes != nil {
+				spec.Probes, applied.Probes = d.Probes, d.Probes
+			}
+		}
+		if len(spec.EnvFrom) == 0 && len(d.EnvFrom) > 0 {
+			spec.EnvFrom, applied.EnvFrom = d.EnvFrom, d.EnvFrom
+		}
+		for _, env := range d.Env {
+			if _, found := findEnvVar(env.Name, spec.Env); !found {
+				spec.Env = append(spec.Env, env)
+				applied.Env = append(applied.Env, env)
+			}
+		}
+		if d.Monitoring != nil {
+			if spec.Monitoring == nil {
+				spec.Monitoring = &openlibertyv1beta1.OpenLibertyApplicationMonitoring{}
+			}
+			if applied.Monitoring == nil {
+				applied.Monitoring = &openlibertyv1beta1.OpenLibertyApplicationMonitoring{}
+			}
+			for k, v := range d.Monitoring.Labels {
+				if _, ok := spec.Monitoring.Labels[k]; !ok {
+					if spec.Monitoring.Labels == nil {
+						spec.Monitoring.Labels = map[string]string{}
+					}
+					if applied.Monitoring.Labels == nil {
+						applied.Monitoring.Labels = map[string]string{}
+					}
+					spec.Monitoring.Labels[k], applied.Monitoring.Labels[k] = v, v
+				}
+			}
+			if len(spec.Monitoring.Endpoints) == 0 && len(d.Monitoring.Endpoints) > 0 {
+				spec.Monitoring.Endpoints, applied.Monitoring.Endpoints = d.Monitoring.Endpoints, d.Monitoring.Endpoints
+			}
+		}
+	}
+
+	if applied.ResourceConstraints != nil && len(applied.ResourceConstraints.Requests) == 0 && len(applied.ResourceConstraints.Limits) == 0 {
+		applied.ResourceConstraints = nil
+	}
+	if applied.Monitoring != nil && len(applied.Monitoring.Labels) == 0 && len(applied.Monitoring.Endpoints) == 0 {
+		applied.Monitoring = nil
+	}
+	if isEmptyDefaults(applied) {
+		return nil
+	}
+	return applied
+}
+
+// mergeResources adds the quantities of the resources that aren't set by the application
+func mergeResources(app, defaults corev1.ResourceList, applied *corev1.ResourceList) corev1.ResourceList {
+	for name, quantity := range defaults {
+		if _, ok := app[name]; ok {
+			continue
+		}
+		if app == nil {
+			app = corev1.ResourceList{}
+		}
+		if *applied == nil {
+			*applied = corev1.ResourceList{}
+		}
+		app[name], (*applied)[name] = quantity, quantity
+	}
+	return app
+}
+
+func isEmptyDefaults(d *openlibertyv1beta1.OpenLibertyDefaultsSpec) bool {
+	return d.PullPolicy == nil && d.PullSecret == nil && d.ServiceAccountName == nil && d.ResourceConstraints == nil &&
+		d.ReadinessProbe == nil && d.LivenessProbe == nil && d.StartupProbe == nil && d.Probes == nil &&
+		len(d.EnvFrom) == 0 && len(d.Env) == 0 && d.Monitoring == nil
+}
@@ -0,0 +1,26 @@
+package utils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// mountConfigDropin mounts a key of a ConfigMap as a configDropin of the application container. Files mounted with
+// subPath are not refreshed when the ConfigMap changes, so the hash of the data is set in the given annotation of the
+// pod template to roll the pods instead.
+func mountConfigDropin(pts *corev1.PodTemplateSpec, volumeName, configMapName, key, mountPath, hashAnnotation string, data map[string]string) {
+	pts.Spec.Volumes = append(pts.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+			},
+		},
+	})
+	pts.Spec.Containers[0].VolumeMounts = append(pts.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{Name: volumeName, MountPath: mountPath, SubPath: key, ReadOnly: true})
+
+	if pts.Annotations == nil {
+		pts.Annotations = map[string]string{}
+	}
+	pts.Annotations[hashAnnotation] = hashData(data)
+}
@@ -0,0 +1,274 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	dockerHubRegistry = "registry-1.docker.io"
+	manifestMediaType = "application/vnd.docker.distribution.manifest.list.v2+json," +
+		"application/vnd.docker.distribution.manifest.v2+json," +
+		"application/vnd.oci.image.index.v1+json," +
+		"application/vnd.oci.image.manifest.v1+json"
+)
+
+var authParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// ImageDigestResolver resolves image tags to digests through the registry API
+type ImageDigestResolver struct {
+	Client *http.Client
+}
+
+// registryCredentials holds the credentials of a registry found in a pull secret
+type registryCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// ParseImageReference splits an image into its registry host, repository and tag or digest
+func ParseImageReference(image string) (string, string, string) {
+	registry, remainder := dockerHubRegistry, image
+	if i := strings.Index(image, "/"); i > 0 {
+		host := image[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			registry, remainder = host, image[i+1:]
+		}
+	}
+	if registry == "docker.io" || registry == "index.docker.io" {
+		registry = dockerHubRegistry
+	}
+
+	reference := "latest"
+	if i := strings.Index(remainder, "@"); i > 0 {
+		remainder, reference = remainder[:i], remainder[i+1:]
+	} else if i := strings.LastIndex(remainder, ":"); i > 0 {
+		remainder, reference = remainder[:i], remainder[i+1:]
+	}
+	if registry == dockerHubRegistry && !strings.Contains(remainder, "/") {
+		remainder = "library/" + remainder
+	}
+	return registry, remainder, reference
+}
+
+// IsImageDigest returns true when the image is already pinned to a digest
+func IsImageDigest(image string) bool {
+	return strings.Contains(image, "@sha256:")
+}
+
+// GetImageWithDigest returns the image pinned to the given digest
+func GetImageWithDigest(image, digest string) string {
+	name := image
+	if i := strings.Index(name, "@"); i > 0 {
+		name = name[:i]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name + "@" + digest
+}
+
+// IsImageDigestResolutionDue returns whether the image tag needs to be resolved, or else the time left
+// before it is polled again
+func IsImageDigestResolutionDue(la *openlibertyv1beta1.OpenLibertyApplication, now time.Time) (bool, time.Duration) {
+	if la.GetImageDigest() == nil || IsImageDigest(la.GetApplicationImage()) {
+		return false, 0
+	}
+	if la.Status.ImageReference != la.GetApplicationImage() || la.Status.ResolvedImage == "" || la.Status.ImageLastResolved == nil {
+		return true, 0
+	}
+	interval, err := time.ParseDuration(la.GetImageDigest().PollInterval)
+	if err != nil || interval <= 0 {
+		return false, 0
+	}
+	if delay := la.Status.ImageLastResolved.Add(interval).Sub(now); delay > 0 {
+		return false, delay
+	}
+	return true, 0
+}
+
+// CustomizeImageDigest pins the application container to the resolved digest of the application image
+func CustomizeImageDigest(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	if la.GetImageDigest() == nil || la.Status.ResolvedImage == "" || la.Status.ImageReference != la.GetApplicationImage() {
+		return
+	}
+	pts.Spec.Containers[0].Image = la.Status.ResolvedImage
+}
+
+// Resolve returns the digest of the manifest the image tag points to. The pull secret, if any,
+// is used to authenticate with the registry.
+func (r *ImageDigestResolver) Resolve(image string, pullSecret *corev1.Secret) (string, error) {
+	registry, repository, reference := ParseImageReference(image)
+	if strings.HasPrefix(reference, "sha256:") {
+		return reference, nil
+	}
+	creds := getRegistryCredentials(pullSecret, registry)
+
+	client := r.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repository, reference)
+
+	authorization := ""
+	resp, err := r.requestManifest(client, http.MethodHead, manifestURL, authorization)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		if authorization, err = r.authorize(client, resp.Header.Get("WWW-Authenticate"), creds); err != nil {
+			return "", err
+		}
+		if resp, err = r.requestManifest(client, http.MethodHead, manifestURL, authorization); err != nil {
+			return "", err
+		}
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to resolve the digest of image %s: registry returned %s", image, resp.Status)
+	}
+	if digest := resp.Header.Get("Docker-Content-Digest"); digest != "" {
+		return digest, nil
+	}
+
+	// Not all registries return the digest, in which case it is computed from the manifest
+	if resp, err = r.requestManifest(client, http.MethodGet, manifestURL, authorization); err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to resolve the digest of image %s: registry returned %s", image, resp.Status)
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(body)), nil
+}
+
+func (r *ImageDigestResolver) requestManifest(client *http.Client, method, manifestURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequest(method, manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", manifestMediaType)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to query the registry: %v", err)
+	}
+	return resp, nil
+}
+
+// authorize answers the authentication challenge of the registry
+func (r *ImageDigestResolver) authorize(client *http.Client, challenge string, creds *registryCredentials) (string, error) {
+	params := map[string]string{}
+	for _, m := range authParam.FindAllStringSubmatch(challenge, -1) {
+		params[m[1]] = m[2]
+	}
+
+	if strings.HasPrefix(strings.ToLower(challenge), "basic") {
+		if creds == nil {
+			return "", fmt.Errorf("Registry requires credentials, set them in the pull secret")
+		}
+		return "Basic " + basicAuth(creds), nil
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return "", fmt.Errorf("Registry returned an invalid authentication challenge: %s", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if creds != nil {
+		req.Header.Set("Authorization", "Basic "+basicAuth(creds))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Failed to get a registry token: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to get a registry token: %s", resp.Status)
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("Failed to parse the registry token: %v", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return "Bearer " + token.Token, nil
+}
+
+func basicAuth(creds *registryCredentials) string {
+	if creds.Auth != "" {
+		return creds.Auth
+	}
+	return base64.StdEncoding.EncodeToString([]byte(creds.Username + ":" + creds.Password))
+}
+
+// getRegistryCredentials looks up the credentials of the registry in a docker config pull secret
+func getRegistryCredentials(secret *corev1.Secret, registry string) *registryCredentials {
+	if secret == nil {
+		return nil
+	}
+	auths := map[string]registryCredentials{}
+	if data, ok := secret.Data[corev1.DockerConfigJsonKey]; ok {
+		config := struct {
+			Auths map[string]registryCredentials `json:"auths"`
+		}{}
+		if json.Unmarshal(data, &config) != nil {
+			return nil
+		}
+		auths = config.Auths
+	} else if data, ok := secret.Data[corev1.DockerConfigKey]; ok {
+		if json.Unmarshal(data, &auths) != nil {
+			return nil
+		}
+	}
+
+	for key, creds := range auths {
+		host := strings.TrimPrefix(strings.TrimPrefix(key, "https://"), "http://")
+		if i := strings.Index(host, "/"); i >= 0 {
+			host = host[:i]
+		}
+		if host == "docker.io" || host == "index.docker.io" {
+			host = dockerHubRegistry
+		}
+		if host == registry {
+			c := creds
+			return &c
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+)
+
+var (
+	applicationStartedRegexp = regexp.MustCompile(`Application (\S+) started`)
+	applicationStoppedRegexp = regexp.MustCompile(`application (\S+) has stopped`)
+	featuresRegexp           = regexp.MustCompile(`\[(.*)\]`)
+	urlRegexp                = regexp.MustCompile(`https?://\S+`)
+	secondsRegexp            = regexp.MustCompile(`[0-9]+[.,][0-9]+`)
+)
+
+// IsInventoryEnabled returns true when the applications and the features started by the pods are reported in the status
+func IsInventoryEnabled(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	lw := la.GetLogWatcher()
+	return IsLogWatcherEnabled(la) && lw.Inventory != nil && *lw.Inventory
+}
+
+// UpdatePodInventory updates the inventory of the pod with a message of its server, and returns true when it changed.
+// The inventory is reset when the server is launched again, e.g. after the container restarted.
+func UpdatePodInventory(inventory *openlibertyv1beta1.OpenLibertyApplicationPodInventory, m *LibertyMessage) bool {
+	switch m.MessageID {
+	case "CWWKE0001I":
+		if len(inventory.Applications) == 0 && len(inventory.ContextRoots) == 0 && len(inventory.Features) == 0 && inventory.StartDuration == "" {
+			return false
+		}
+		*inventory = openlibertyv1beta1.OpenLibertyApplicationPodInventory{PodName: inventory.PodName}
+		return true
+	case "CWWKF0011I":
+		d := parseSeconds(m.Message)
+		if d == "" || d == inventory.StartDuration {
+			return false
+		}
+		inventory.StartDuration = d
+		return true
+	case "CWWKF0012I":
+		return addValues(&inventory.Features, parseFeatures(m.Message)...)
+	case "CWWKF0013I":
+		return removeValues(&inventory.Features, parseFeatures(m.Message)...)
+	case "CWWKZ0001I":
+		if match := applicationStartedRegexp.FindStringSubmatch(m.Message); match != nil {
+			return addValues(&inventory.Applications, strings.TrimSuffix(match[1], "."))
+		}
+	case "CWWKZ0009I":
+		if match := applicationStoppedRegexp.FindStringSubmatch(m.Message); match != nil {
+			return removeValues(&inventory.Applications, match[1])
+		}
+	case "CWWKT0016I":
+		if root := parseContextRoot(m.Message); root != "" {
+			return addValues(&inventory.ContextRoots, root)
+		}
+	case "CWWKT0017I":
+		if root := parseContextRoot(m.Message); root != "" {
+			return removeValues(&inventory.ContextRoots, root)
+		}
+	}
+	return false
+}
+
+// SetInventoryStatus reports the inventories of the pods, sorted by pod, in the status. The inventories are removed
+// when the inventory is disabled.
+func SetInventoryStatus(la *openlibertyv1beta1.OpenLibertyApplication, inventories []openlibertyv1beta1.OpenLibertyApplicationPodInventory) {
+	if !IsInventoryEnabled(la) || len(inventories) == 0 {
+		la.Status.Inventory = nil
+		return
+	}
+	sort.Slice(inventories, func(i, j int) bool {
+		return inventories[i].PodName < inventories[j].PodName
+	})
+	la.Status.Inventory = inventories
+}
+
+// GetInventoryMismatch returns a message naming the started pods that don't run the same applications, context roots
+// and features as most pods, or an empty string when they all agree
+func GetInventoryMismatch(inventories []openlibertyv1beta1.OpenLibertyApplicationPodInventory) string {
+	groups := map[string][]string{}
+	keys := []string{}
+	for _, inv := range inventories {
+		if inv.StartDuration == "" {
+			// The server is still starting
+			continue
+		}
+		key := strings.Join(inv.Applications, ",") + ";" + strings.Join(inv.ContextRoots, ",") + ";" + strings.Join(inv.Features, ",")
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], inv.PodName)
+	}
+	if len(keys) < 2 {
+		return ""
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return len(groups[keys[i]]) > len(groups[keys[j]])
+	})
+	others := []string{}
+	for _, key := range keys[1:] {
+		others = append(others, groups[key]...)
+	}
+	sort.Strings(others)
+	return fmt.Sprintf("Pods %s started different applications, context roots or features than pod %s",
+		strings.Join(others, ", "), groups[keys[0]][0])
+}
+
+// parseSeconds returns the duration in seconds of the message, e.g. 5.123s
+func parseSeconds(message string) string {
+	matches := secondsRegexp.FindAllString(message, -1)
+	if len(matches) == 0 {
+		return ""
+	}
+	seconds, err := strconv.ParseFloat(strings.Replace(matches[len(matches)-1], ",", ".", 1), 64)
+	if err != nil {
+		return ""
+	}
+	return time.Duration(seconds * float64(time.Second)).Round(time.Millisecond).String()
+}
+
+// parseFeatures returns the features listed between brackets in the message
+func parseFeatures(message string) []string {
+	match := featuresRegexp.FindStringSubmatch(message)
+	if match == nil {
+		return nil
+	}
+	features := []string{}
+	for _, f := range strings.Split(match[1], ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			features = append(features, f)
+		}
+	}
+	return features
+}
+
+// parseContextRoot returns the context root of the URL of the web application in the message
+func parseContextRoot(message string) string {
+	u, err := url.Parse(urlRegexp.FindString(message))
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	if root := strings.TrimSuffix(u.Path, "/"); root != "" {
+		return root
+	}
+	return "/"
+}
+
+// addValues adds the values missing from the sorted list, and returns true when any was added
+func addValues(list *[]string, values ...string) bool {
+	changed := false
+	for _, v := range values {
+		i := sort.SearchStrings(*list, v)
+		if i < len(*list) && (*list)[i] == v {
+			continue
+		}
+		*list = append(*list, "")
+		copy((*list)[i+1:], (*list)[i:])
+		(*list)[i] = v
+		changed = true
+	}
+	return changed
+}
+
+// removeValues removes the values from the sorted list, and returns true when any was removed
+func removeValues(list *[]string, values ...string) bool {
+	changed := false
+	for _, v := range values {
+		i := sort.SearchStrings(*list, v)
+		if i < len(*list) && (*list)[i] == v {
+			*list = append((*list)[:i], (*list)[i+1:]...)
+			changed = true
+		}
+	}
+	if len(*list) == 0 {
+		*list = nil
+	}
+	return changed
+}
@@ -0,0 +1,312 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	loggingDropinMountPath = "/config/configDropins/overrides/logging.xml"
+	loggingVolumeName      = "logging-config"
+	loggingHashAnnotation  = "openliberty.io/logging-config-hash"
+
+	runtimeLoggingDropinPath = "/config/configDropins/overrides/runtime_logging.xml"
+	// RuntimeLoggingAnnotation records on a pod the runtime logging overrides applied to the container of its server,
+	// as the hash of the overrides and the ID of the container
+	RuntimeLoggingAnnotation = "openliberty.io/runtime-logging"
+
+	defaultConsoleLogLevel = "info"
+	defaultConsoleSource   = "message,accessLog,ffdc,audit"
+	defaultConsoleFormat   = "json"
+)
+
+// loggingEnv maps the fields of spec.logging to the env variables they set
+var loggingEnv = map[string]string{
+	"consoleLogLevel":     "WLP_LOGGING_CONSOLE_LOGLEVEL",
+	"consoleSources":      "WLP_LOGGING_CONSOLE_SOURCE",
+	"consoleFormat":       "WLP_LOGGING_CONSOLE_FORMAT",
+	"jsonFieldMappings":   "WLP_LOGGING_JSON_FIELD_MAPPINGS",
+	"jsonAccessLogFields": "WLP_LOGGING_JSON_ACCESS_LOG_FIELDS",
+}
+
+// GetLoggingConfigMapName returns the name of the ConfigMap holding the logging configuration
+func GetLoggingConfigMapName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	return la.Name + "-logging"
+}
+
+// IsLoggingDropinRequired returns true when the logging configuration has settings that can't be set with env variables
+func IsLoggingDropinRequired(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	l := la.GetLogging()
+	return l != nil && (l.MaxFileSize != nil || l.MaxFiles != nil || l.AccessLogFormat != "")
+}
+
+// GetConsoleFormat returns the format of the console logs
+func GetConsoleFormat(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	if l := la.GetLogging(); l != nil && l.ConsoleFormat != "" {
+		return l.ConsoleFormat
+	}
+	return defaultConsoleFormat
+}
+
+// GetConsoleLogLevel returns the level of the messages written to the console
+func GetConsoleLogLevel(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	if l := la.GetLogging(); l != nil && l.ConsoleLogLevel != "" {
+		return l.ConsoleLogLevel
+	}
+	return defaultConsoleLogLevel
+}
+
+// GetConsoleSources returns the sources written to the console
+func GetConsoleSources(la *openlibertyv1beta1.OpenLibertyApplication) []string {
+	if l := la.GetLogging(); l != nil && len(l.ConsoleSources) > 0 {
+		sources := []string{}
+		for _, s := range l.ConsoleSources {
+			sources = append(sources, string(s))
+		}
+		return sources
+	}
+	return strings.Split(defaultConsoleSource, ",")
+}
+
+// getLoggingEnv returns the env variables configuring the logs of the server, with the default console settings
+func getLoggingEnv(la *openlibertyv1beta1.OpenLibertyApplication) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: loggingEnv["consoleLogLevel"], Value: GetConsoleLogLevel(la)},
+		{Name: loggingEnv["consoleSources"], Value: strings.Join(GetConsoleSources(la), ",")},
+		{Name: loggingEnv["consoleFormat"], Value: GetConsoleFormat(la)},
+	}
+	l := la.GetLogging()
+	if l == nil {
+		return env
+	}
+	if len(l.JSONFieldMappings) > 0 {
+		fields := make([]string, 0, len(l.JSONFieldMappings))
+		for field := range l.JSONFieldMappings {
+			fields = append(fields, field)
+		}
+		sort.Strings(fields)
+		mappings := []string{}
+		for _, field := range fields {
+			mappings = append(mappings, field+":"+l.JSONFieldMappings[field])
+		}
+		env = append(env, corev1.EnvVar{Name: loggingEnv["jsonFieldMappings"], Value: strings.Join(mappings, ",")})
+	}
+	if l.JSONAccessLogFields != "" {
+		env = append(env, corev1.EnvVar{Name: loggingEnv["jsonAccessLogFields"], Value: l.JSONAccessLogFields})
+	}
+	return env
+}
+
+// CustomizeLoggingConfigMap renders the logging configDropin
+func CustomizeLoggingConfigMap(cm *corev1.ConfigMap, la *openlibertyv1beta1.OpenLibertyApplication) {
+	cm.Labels = la.GetLabels()
+	cm.Annotations = la.GetAnnotations()
+	cm.Data = map[string]string{"logging.xml": renderLoggingConfig(la)}
+}
+
+// ConfigureLogging mounts the logging configuration into the application container
+func ConfigureLogging(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	if !IsLoggingDropinRequired(la) {
+		delete(pts.Annotations, loggingHashAnnotation)
+		return
+	}
+
+	mountConfigDropin(pts, loggingVolumeName, GetLoggingConfigMapName(la), "logging.xml", loggingDropinMountPath,
+		loggingHashAnnotation, map[string]string{"logging.xml": renderLoggingConfig(la)})
+}
+
+func renderLoggingConfig(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	l := la.GetLogging()
+	var b bytes.Buffer
+
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<server>\n")
+	if l.MaxFileSize != nil || l.MaxFiles != nil {
+		b.WriteString("  <logging")
+		if l.MaxFileSize != nil {
+			fmt.Fprintf(&b, " maxFileSize=\"%d\"", *l.MaxFileSize)
+		}
+		if l.MaxFiles != nil {
+			fmt.Fprintf(&b, " maxFiles=\"%d\"", *l.MaxFiles)
+		}
+		b.WriteString("/>\n")
+	}
+	// The dropin overrides the access logging that the server configuration sets for the default endpoint
+	if l.AccessLogFormat != "" {
+		fmt.Fprintf(&b, "  <httpAccessLogging id=\"operatorAccessLogging\" logFormat=\"%s\"/>\n", xmlEscape(l.AccessLogFormat))
+		b.WriteString("  <httpEndpoint id=\"defaultHttpEndpoint\" accessLoggingRef=\"operatorAccessLogging\"/>\n")
+	}
+	b.WriteString("</server>\n")
+	return b.String()
+}
+
+// GetRuntimeLoggingHash returns the hash of the runtime logging overrides, or an empty string when there are none
+func GetRuntimeLoggingHash(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	if !hasRuntimeOverrides(la) {
+		return ""
+	}
+	return hashData(map[string]string{"runtime_logging.xml": renderRuntimeLoggingConfig(la)})
+}
+
+// GetRuntimeLoggingCommand returns the command writing the runtime logging overrides to the configDropin, which the
+// server applies without restarting, or removing it when there are none
+func GetRuntimeLoggingCommand(la *openlibertyv1beta1.OpenLibertyApplication) []string {
+	if !hasRuntimeOverrides(la) {
+		return []string{"/bin/sh", "-c", "rm -f " + runtimeLoggingDropinPath}
+	}
+	config := strings.Replace(renderRuntimeLoggingConfig(la), "'", `'\''`, -1)
+	return []string{"/bin/sh", "-c", "printf '%s' '" + config + "' > " + runtimeLoggingDropinPath}
+}
+
+// GetLibertyContainerID returns the ID of the running container of the server of the pod, or an empty string
+func GetLibertyContainerID(pod *corev1.Pod) string {
+	name := GetLibertyContainerName(pod)
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == name && cs.State.Running != nil {
+			return cs.ContainerID
+		}
+	}
+	return ""
+}
+
+// GetAppliedRuntimeLoggingHash returns the hash of the runtime logging overrides applied to the running container of
+// the server of the pod. A new container, e.g. after a restart, has none.
+func GetAppliedRuntimeLoggingHash(pod *corev1.Pod) string {
+	parts := strings.SplitN(pod.Annotations[RuntimeLoggingAnnotation], "@", 2)
+	if len(parts) != 2 || parts[1] != GetLibertyContainerID(pod) {
+		return ""
+	}
+	return parts[0]
+}
+
+// SetAppliedRuntimeLoggingHash records the hash of the runtime logging overrides applied to the running container of
+// the server of the pod
+func SetAppliedRuntimeLoggingHash(pod *corev1.Pod, hash string) {
+	if hash == "" {
+		delete(pod.Annotations, RuntimeLoggingAnnotation)
+		return
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[RuntimeLoggingAnnotation] = hash + "@" + GetLibertyContainerID(pod)
+}
+
+func hasRuntimeOverrides(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	l := la.GetLogging()
+	if l == nil || l.RuntimeOverrides == nil {
+		return false
+	}
+	o := l.RuntimeOverrides
+	return o.ConsoleLogLevel != "" || len(o.ConsoleSources) > 0 || len(o.MessageSources) > 0 || o.MessageFormat != ""
+}
+
+func renderRuntimeLoggingConfig(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	o := la.GetLogging().RuntimeOverrides
+	joinSources := func(sources []openlibertyv1beta1.OpenLibertyLoggingSource) string {
+		values := []string{}
+		for _, s := range sources {
+			values = append(values, string(s))
+		}
+		return strings.Join(values, ",")
+	}
+	var b bytes.Buffer
+
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<server>\n  <logging")
+	if o.ConsoleLogLevel != "" {
+		fmt.Fprintf(&b, " consoleLogLevel=\"%s\"", o.ConsoleLogLevel)
+	}
+	if len(o.ConsoleSources) > 0 {
+		fmt.Fprintf(&b, " consoleSource=\"%s\"", joinSources(o.ConsoleSources))
+	}
+	if len(o.MessageSources) > 0 {
+		fmt.Fprintf(&b, " messageSource=\"%s\"", joinSources(o.MessageSources))
+	}
+	if o.MessageFormat != "" {
+		fmt.Fprintf(&b, " messageFormat=\"%s\"", o.MessageFormat)
+	}
+	b.WriteString("/>\n</server>\n")
+	return b.String()
+}
+
+// validateLogging returns an error when the logging configuration conflicts with the env variables of the
+// application or doesn't provide the logs needed by the log watcher
+func validateLogging(la *openlibertyv1beta1.OpenLibertyApplication) error {
+	l := la.GetLogging()
+	if l == nil {
+		return nil
+	}
+	if la.Spec.CreateKnativeService != nil && *la.Spec.CreateKnativeService {
+		return fmt.Errorf("spec.logging is not supported when spec.createKnativeService is enabled")
+	}
+
+	set := map[string]bool{
+		"consoleLogLevel":     l.ConsoleLogLevel != "",
+		"consoleSources":      len(l.ConsoleSources) > 0,
+		"consoleFormat":       l.ConsoleFormat != "",
+		"jsonFieldMappings":   len(l.JSONFieldMappings) > 0,
+		"jsonAccessLogFields": l.JSONAccessLogFields != "",
+	}
+	fields := []string{"consoleLogLevel", "consoleSources", "consoleFormat", "jsonFieldMappings", "jsonAccessLogFields"}
+	for _, field := range fields {
+		if _, found := findEnvVar(loggingEnv[field], la.Spec.Env); found && set[field] {
+			return fmt.Errorf("spec.logging.%s conflicts with the %s environment variable", field, loggingEnv[field])
+		}
+	}
+
+	for field, name := range l.JSONFieldMappings {
+		if field == "" || name == "" || strings.ContainsAny(field+name, ", ") {
+			return fmt.Errorf("spec.logging.jsonFieldMappings has an invalid mapping of field %q to %q", field, name)
+		}
+	}
+	json := GetConsoleFormat(la) == "json"
+	if (set["jsonFieldMappings"] || set["jsonAccessLogFields"]) && !json {
+		return fmt.Errorf("spec.logging.jsonFieldMappings and spec.logging.jsonAccessLogFields require the json spec.logging.consoleFormat")
+	}
+
+	if !IsLogWatcherEnabled(la) {
+		return nil
+	}
+	if !json {
+		return fmt.Errorf("spec.logWatcher requires the json spec.logging.consoleFormat")
+	}
+	if err := validateLogWatcherConsole(la, "spec.logging", GetConsoleLogLevel(la), GetConsoleSources(la)); err != nil {
+		return err
+	}
+	if o := l.RuntimeOverrides; o != nil && (o.ConsoleLogLevel != "" || len(o.ConsoleSources) > 0) {
+		level, sources := o.ConsoleLogLevel, GetConsoleSources(la)
+		if level == "" {
+			level = GetConsoleLogLevel(la)
+		}
+		if len(o.ConsoleSources) > 0 {
+			sources = []string{}
+			for _, s := range o.ConsoleSources {
+				sources = append(sources, string(s))
+			}
+		}
+		return validateLogWatcherConsole(la, "spec.logging.runtimeOverrides", level, sources)
+	}
+	return nil
+}
+
+// validateLogWatcherConsole returns an error when the console level or sources hide messages read by the log watcher
+func validateLogWatcherConsole(la *openlibertyv1beta1.OpenLibertyApplication, path, level string, sources []string) error {
+	set := map[string]bool{}
+	for _, s := range sources {
+		set[s] = true
+	}
+	if !set["message"] {
+		return fmt.Errorf("spec.logWatcher requires the message source in %s.consoleSources", path)
+	}
+	if IsFFDCEnabled(la) && !set["ffdc"] {
+		return fmt.Errorf("spec.logWatcher.ffdc requires the ffdc source in %s.consoleSources", path)
+	}
+	if IsInventoryEnabled(la) && level != "info" && level != "audit" {
+		return fmt.Errorf("spec.logWatcher.inventory requires the info or audit %s.consoleLogLevel", path)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// libertyDatetimeLayout is the layout of the ibm_datetime field of the JSON logs
+const libertyDatetimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// DefaultLogWatcherMessageIDs are the IDs of the messages the log watcher reports as errors: application start
+// failures, feature resolution errors, SSL errors and hung threads
+var DefaultLogWatcherMessageIDs = []string{"CWWKZ0002E", "CWWKF0001E", "CWPKI0022E", "CWPKI0033E", "CWWKO0801E", "TRAS0112W"}
+
+// LibertyMessage is a message of the JSON console log of the server
+type LibertyMessage struct {
+	Type      string `json:"type"`
+	Datetime  string `json:"ibm_datetime"`
+	MessageID string `json:"ibm_messageId"`
+	Message   string `json:"message"`
+}
+
+// GetTime returns the time the message was logged, or the current time when it can't be read
+func (m *LibertyMessage) GetTime() time.Time {
+	return parseLibertyDatetime(m.Datetime)
+}
+
+// LibertyFFDC is an FFDC incident of the JSON console log of the server
+type LibertyFFDC struct {
+	Type          string `json:"type"`
+	Datetime      string `json:"ibm_datetime"`
+	ClassName     string `json:"ibm_className"`
+	ExceptionName string `json:"ibm_exceptionName"`
+	ProbeID       string `json:"ibm_probeID"`
+}
+
+// GetTime returns the time of the incident, or the current time when it can't be read. The time is truncated to
+// the second, as it is once serialized.
+func (f *LibertyFFDC) GetTime() metav1.Time {
+	return metav1.NewTime(parseLibertyDatetime(f.Datetime).Truncate(time.Second))
+}
+
+func parseLibertyDatetime(datetime string) time.Time {
+	t, err := time.Parse(libertyDatetimeLayout, datetime)
+	if err != nil {
+		return time.Now()
+	}
+	return t
+}
+
+// IsLogWatcherEnabled returns true when the logs of the pods of the application are followed
+func IsLogWatcherEnabled(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	lw := la.GetLogWatcher()
+	return lw != nil && lw.Enabled != nil && *lw.Enabled
+}
+
+// GetLogWatcherMessageIDs returns the IDs of the messages the log watcher reports for the application
+func GetLogWatcherMessageIDs(la *openlibertyv1beta1.OpenLibertyApplication) map[string]bool {
+	ids := map[string]bool{}
+	for _, id := range DefaultLogWatcherMessageIDs {
+		ids[id] = true
+	}
+	if lw := la.GetLogWatcher(); lw != nil {
+		for _, id := range lw.MessageIDs {
+			ids[id] = true
+		}
+	}
+	return ids
+}
+
+// ParseLibertyMessage reads a line of the JSON console log. Lines that are not messages of the server, e.g. access
+// logs or the output of the launch script, are skipped.
+func ParseLibertyMessage(line string) (*LibertyMessage, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+	m := &LibertyMessage{}
+	if json.Unmarshal([]byte(line), m) != nil || m.Type != "liberty_message" || m.MessageID == "" {
+		return nil, false
+	}
+	return m, true
+}
+
+// ParseLibertyFFDC reads an FFDC incident from a line of the JSON console log
+func ParseLibertyFFDC(line string) (*LibertyFFDC, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return nil, false
+	}
+	f := &LibertyFFDC{}
+	if json.Unmarshal([]byte(line), f) != nil || f.Type != "liberty_ffdc" || f.ExceptionName == "" {
+		return nil, false
+	}
+	return f, true
+}
+
+// IsFFDCEnabled returns true when the FFDC incidents of the pods are aggregated in an OpenLibertyIncidents
+func IsFFDCEnabled(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	lw := la.GetLogWatcher()
+	return IsLogWatcherEnabled(la) && lw.FFDC != nil && lw.FFDC.Enabled != nil && *lw.FFDC.Enabled
+}
+
+// IsFFDCCopyEnabled returns true when the incident files are copied to the serviceability volume
+func IsFFDCCopyEnabled(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	return IsFFDCEnabled(la) && la.GetLogWatcher().FFDC.CopyToServiceability != nil && *la.GetLogWatcher().FFDC.CopyToServiceability
+}
+
+// GetFFDCCopyCommand returns the directory of the serviceability volume the incident files of the pod are copied to,
+// and the command copying them
+func GetFFDCCopyCommand(namespace, podName string) (string, []string) {
+	dir := serviceabilityMountPath + "/" + namespace + "/" + podName + "/ffdc"
+	return dir, []string{"/bin/sh", "-c", "mkdir -p " + dir + ` && cp -p "${LOG_DIR:-/logs}"/ffdc/* ` + dir + "/"}
+}
+
+// SetIncidentsStatus reports the incidents of the pods, sorted by pod and by last occurrence
+func SetIncidentsStatus(incidents *openlibertyv1beta1.OpenLibertyIncidents, pods []openlibertyv1beta1.OpenLibertyIncidentsPod) {
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].PodName < pods[j].PodName
+	})
+	incidents.Status.TotalCount = 0
+	incidents.Status.LastSeen = nil
+	for i := range pods {
+		list := pods[i].Incidents
+		sort.Slice(list, func(i, j int) bool {
+			if !list[i].LastSeen.Equal(&list[j].LastSeen) {
+				return list[j].LastSeen.Before(&list[i].LastSeen)
+			}
+			return list[i].ExceptionName+list[i].ProbeID < list[j].ExceptionName+list[j].ProbeID
+		})
+		for j := range list {
+			incidents.Status.TotalCount += list[j].Count
+			if incidents.Status.LastSeen == nil || incidents.Status.LastSeen.Before(&list[j].LastSeen) {
+				lastSeen := list[j].LastSeen
+				incidents.Status.LastSeen = &lastSeen
+			}
+		}
+	}
+	if len(pods) == 0 {
+		pods = nil
+	}
+	incidents.Status.Pods = pods
+}
+
+// SetApplicationErrorsStatus reports the errors logged by the pods in the status and the ApplicationErrors
+// condition. The condition is unknown when the logs of some pods can't be followed, and removed when the log watcher
+// is disabled.
+func SetApplicationErrorsStatus(la *openlibertyv1beta1.OpenLibertyApplication, podErrors []openlibertyv1beta1.OpenLibertyApplicationPodErrors, failures []string) {
+	if !IsLogWatcherEnabled(la) {
+		la.Status.ApplicationErrors = nil
+		conditions := la.Status.Conditions[:0]
+		for _, c := range la.Status.Conditions {
+			if c.Type != openlibertyv1beta1.StatusConditionTypeApplicationErrors {
+				conditions = append(conditions, c)
+			}
+		}
+		la.Status.Conditions = conditions
+		return
+	}
+
+	sort.Slice(podErrors, func(i, j int) bool {
+		return podErrors[i].PodName < podErrors[j].PodName
+	})
+	summary := []string{}
+	for i := range podErrors {
+		messages := podErrors[i].Messages
+		sort.Slice(messages, func(i, j int) bool {
+			return messages[i].MessageID < messages[j].MessageID
+		})
+		counts := []string{}
+		for _, m := range messages {
+			counts = append(counts, fmt.Sprintf("%s (%d)", m.MessageID, m.Count))
+		}
+		summary = append(summary, podErrors[i].PodName+": "+strings.Join(counts, ", "))
+	}
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		if len(podErrors) == 0 {
+			podErrors = nil
+		}
+		la.Status.ApplicationErrors = podErrors
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeApplicationErrors, corev1.ConditionUnknown, "LogsUnavailable",
+			"Failed to follow the logs of pods "+strings.Join(failures, "; "))
+		return
+	}
+	if len(podErrors) == 0 {
+		la.Status.ApplicationErrors = nil
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeApplicationErrors, corev1.ConditionFalse, "NoErrors",
+			"The servers of the pods didn't log errors")
+		return
+	}
+	la.Status.ApplicationErrors = podErrors
+	SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeApplicationErrors, corev1.ConditionTrue, "ErrorsLogged",
+		strings.Join(summary, "; "))
+}
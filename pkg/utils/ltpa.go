@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	ltpaKeysMountPath    = "/config/managedLTPA"
+	ltpaDropinMountPath  = "/config/configDropins/overrides/ltpa.xml"
+	ltpaVolumeName       = "ltpa-keys"
+	ltpaHashAnnotation   = "openliberty.io/ltpa-keys-hash"
+	ltpaNamespaceSecret  = "openliberty-namespace-ltpa"
+	ltpaKeygenFile       = "/tmp/ltpa.keys"
+	ltpaKeygenTimeout    = 600
+	ltpaKeysKey          = "ltpa.keys"
+	ltpaPasswordKey      = "password"
+	ltpaNextPasswordKey  = "nextPassword"
+	ltpaPrevKeysKey      = "previous.ltpa.keys"
+	ltpaPrevPasswordKey  = "previousPassword"
+	ltpaDropinKey        = "ltpa.xml"
+	ltpaKeygenContainer  = "ltpa-keygen"
+	ltpaSecurityUtility  = "/opt/ol/wlp/bin/securityUtility"
+	ltpaRotatedTimestamp = "openliberty.io/ltpa-keys-rotated"
+)
+
+// GetLTPASecretName returns the name of the Secret holding the LTPA keys used by the application
+func GetLTPASecretName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	if la.GetLTPA() != nil && la.GetLTPA().GetScope() == openlibertyv1beta1.OpenLibertyApplicationLTPAScopeNamespace {
+		return ltpaNamespaceSecret
+	}
+	return GetApplicationLTPASecretName(la)
+}
+
+// GetApplicationLTPASecretName returns the name of the Secret holding the LTPA keys of the application scope. Its
+// suffix differs from the name of the namespace Secret, so that no application name maps to it.
+func GetApplicationLTPASecretName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	return la.Name + "-app-ltpa"
+}
+
+// GetLTPAJobName returns the name of the Job generating the LTPA keys
+func GetLTPAJobName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	return GetLTPASecretName(la) + "-keygen"
+}
+
+// IsLTPAKeygenRequired returns true when new LTPA keys are waiting to be generated
+func IsLTPAKeygenRequired(secret *corev1.Secret) bool {
+	_, ok := secret.Data[ltpaNextPasswordKey]
+	return ok
+}
+
+// CustomizeLTPASecret requests new keys when the Secret has no keys yet or when the keys are due for rotation
+func CustomizeLTPASecret(secret *corev1.Secret, la *openlibertyv1beta1.OpenLibertyApplication, now time.Time) error {
+	if la.GetLTPA().GetScope() == openlibertyv1beta1.OpenLibertyApplicationLTPAScopeNamespace {
+		secret.Labels = map[string]string{"app.kubernetes.io/managed-by": "open-liberty-operator"}
+	} else {
+		secret.Labels = la.GetLabels()
+	}
+	if IsLTPAKeygenRequired(secret) {
+		return nil
+	}
+	if _, ok := secret.Data[ltpaKeysKey]; ok && GetLTPARotationDelay(secret, la, now) > 0 {
+		return nil
+	}
+
+	password, err := generateLTPAPassword()
+	if err != nil {
+		return err
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[ltpaNextPasswordKey] = []byte(password)
+	return nil
+}
+
+// GetLTPARotationDelay returns the time left before the keys are due for rotation, or a negative value
+// when the keys are not rotated
+func GetLTPARotationDelay(secret *corev1.Secret, la *openlibertyv1beta1.OpenLibertyApplication, now time.Time) time.Duration {
+	if la.GetLTPA() == nil || la.GetLTPA().RotationInterval == "" {
+		return -1
+	}
+	interval, err := time.ParseDuration(la.GetLTPA().RotationInterval)
+	if err != nil {
+		return -1
+	}
+	rotated, err := time.Parse(time.RFC3339, secret.Annotations[ltpaRotatedTimestamp])
+	if err != nil {
+		return 0
+	}
+	if delay := rotated.Add(interval).Sub(now); delay > 0 {
+		return delay
+	}
+	return 0
+}
+
+// CustomizeLTPAJob runs securityUtility from the application image to generate the keys. The container then waits
+// for the operator to read the keys, so that they never leave the pod through its status or its logs.
+func CustomizeLTPAJob(job *batchv1.Job, la *openlibertyv1beta1.OpenLibertyApplication) {
+	job.Labels = map[string]string{
+		"app.kubernetes.io/managed-by": "open-liberty-operator",
+		"app.kubernetes.io/component":  ltpaKeygenContainer,
+	}
+	backoffLimit := int32(2)
+	job.Spec.BackoffLimit = &backoffLimit
+	job.Spec.Template.Labels = job.Labels
+	job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	job.Spec.Template.Spec.ServiceAccountName = GetServiceAccountName(la)
+
+	container := corev1.Container{
+		Name:    ltpaKeygenContainer,
+		Image:   la.GetApplicationImage(),
+		Command: []string{"/bin/sh", "-c"},
+		Args: []string{fmt.Sprintf("%s createLTPAKeys --file=%s.new --password=\"$LTPA_PASSWORD\" > /dev/null"+
+			" && mv %s.new %s && sleep %d", ltpaSecurityUtility, ltpaKeygenFile, ltpaKeygenFile, ltpaKeygenFile, ltpaKeygenTimeout)},
+		Env: []corev1.EnvVar{{
+			Name: "LTPA_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: GetLTPASecretName(la)},
+					Key:                  ltpaNextPasswordKey,
+				},
+			},
+		}},
+	}
+	if la.GetPullPolicy() != nil {
+		container.ImagePullPolicy = *la.GetPullPolicy()
+	}
+	job.Spec.Template.Spec.Containers = []corev1.Container{container}
+	if la.GetPullSecret() != nil {
+		job.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: *la.GetPullSecret()}}
+	}
+}
+
+// IsLTPAKeygenPodRunning returns true when the container of a keygen pod runs, i.e. generates the keys or waits for
+// them to be read
+func IsLTPAKeygenPodRunning(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == ltpaKeygenContainer {
+			return cs.State.Running != nil
+		}
+	}
+	return false
+}
+
+// GetLTPAKeysCommand returns the container and the command printing the generated keys. The keys are printed to the
+// standard error, which ExecuteCommandInContainer returns. The command fails while the keys are being generated.
+func GetLTPAKeysCommand() (string, []string) {
+	return ltpaKeygenContainer, []string{"/bin/sh", "-c", "cat " + ltpaKeygenFile + " >&2"}
+}
+
+// RotateLTPAKeys makes the generated keys current. The previous keys are kept to validate the tokens
+// issued by the pods that are not restarted yet.
+func RotateLTPAKeys(secret *corev1.Secret, keys []byte, now time.Time) {
+	if current, ok := secret.Data[ltpaKeysKey]; ok {
+		secret.Data[ltpaPrevKeysKey] = current
+		secret.Data[ltpaPrevPasswordKey] = secret.Data[ltpaPasswordKey]
+	}
+	secret.Data[ltpaKeysKey] = keys
+	secret.Data[ltpaPasswordKey] = secret.Data[ltpaNextPasswordKey]
+	delete(secret.Data, ltpaNextPasswordKey)
+	secret.Data[ltpaDropinKey] = []byte(renderLTPAConfig(secret))
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[ltpaRotatedTimestamp] = now.UTC().Format(time.RFC3339)
+}
+
+// ConfigureLTPA mounts the shared LTPA keys into the application container once they are generated
+func ConfigureLTPA(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication, secret *corev1.Secret) {
+	if la.GetLTPA() == nil || secret == nil || secret.Data[ltpaKeysKey] == nil {
+		delete(pts.Annotations, ltpaHashAnnotation)
+		return
+	}
+
+	items := []corev1.KeyToPath{{Key: ltpaKeysKey, Path: ltpaKeysKey}, {Key: ltpaDropinKey, Path: ltpaDropinKey}}
+	if secret.Data[ltpaPrevKeysKey] != nil {
+		items = append(items, corev1.KeyToPath{Key: ltpaPrevKeysKey, Path: ltpaPrevKeysKey})
+	}
+	pts.Spec.Volumes = append(pts.Spec.Volumes, corev1.Volume{
+		Name: ltpaVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secret.Name, Items: items},
+		},
+	})
+	pts.Spec.Containers[0].VolumeMounts = append(pts.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{Name: ltpaVolumeName, MountPath: ltpaKeysMountPath, ReadOnly: true},
+		corev1.VolumeMount{Name: ltpaVolumeName, MountPath: ltpaDropinMountPath, SubPath: ltpaDropinKey, ReadOnly: true},
+	)
+
+	// Roll the pods when the keys are rotated so that all replicas switch to the new keys
+	if pts.Annotations == nil {
+		pts.Annotations = map[string]string{}
+	}
+	pts.Annotations[ltpaHashAnnotation] = hashData(map[string]string{
+		ltpaKeysKey:   string(secret.Data[ltpaKeysKey]),
+		ltpaDropinKey: string(secret.Data[ltpaDropinKey]),
+	})
+}
+
+func renderLTPAConfig(secret *corev1.Secret) string {
+	validationKeys := ""
+	if secret.Data[ltpaPrevKeysKey] != nil {
+		validationKeys = fmt.Sprintf("\n    <validationKeys fileName=\"%s/%s\" password=\"%s\"/>\n  ",
+			ltpaKeysMountPath, ltpaPrevKeysKey, xmlEscape(string(secret.Data[ltpaPrevPasswordKey])))
+	}
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<server>
+  <ltpa keysFileName="%s/%s" keysPassword="%s" monitorInterval="0">%s</ltpa>
+</server>
+`, ltpaKeysMountPath, ltpaKeysKey, xmlEscape(string(secret.Data[ltpaPasswordKey])), validationKeys)
+}
+
+func generateLTPAPassword() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("Failed to generate LTPA keys password: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,228 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/appsody/appsody-operator/pkg/common"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "openliberty_operator"
+
+// Results of the reconciliations and day-2 operations reported in the metrics
+const (
+	MetricsResultSuccess = "success"
+	MetricsResultError   = "error"
+)
+
+// Operations of OpenLibertyTrace reported in the metrics
+const (
+	TraceOperationEnable  = "enable"
+	TraceOperationDisable = "disable"
+)
+
+var (
+	reconcileTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconcile_total",
+		Help:      "Number of reconciliations by kind, result and reason",
+	}, []string{"kind", "result", "reason"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of the reconciliations by kind",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"kind"})
+
+	dumpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "dump_duration_seconds",
+		Help:      "Duration of the server dumps by include type",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	}, []string{"include"})
+
+	dumpArchiveSize = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "dump_archive_size_bytes",
+		Help:      "Size of the server dump archives by include type",
+		Buckets:   prometheus.ExponentialBuckets(1024*1024, 2, 12),
+	}, []string{"include"})
+
+	dumpFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "dump_failures_total",
+		Help:      "Number of failed server dumps by include type",
+	}, []string{"include"})
+
+	traceOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "trace_operations_total",
+		Help:      "Number of trace enable and disable operations by result",
+	}, []string{"operation", "result"})
+
+	applicationReconciled = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "application_reconciled",
+		Help:      "Whether the Reconciled condition of the OpenLibertyApplication is true (1) or not (0)",
+	}, []string{"namespace", "application"})
+
+	applicationDesiredReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "application_desired_replicas",
+		Help:      "Number of replicas requested for the OpenLibertyApplication",
+	}, []string{"namespace", "application"})
+
+	applicationReadyReplicas = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "application_ready_replicas",
+		Help:      "Number of ready replicas of the OpenLibertyApplication",
+	}, []string{"namespace", "application"})
+
+	leader = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "leader",
+		Help:      "Whether this replica of the operator is the leader (1) or on standby (0)",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(reconcileTotal, reconcileDuration, dumpDuration, dumpArchiveSize, dumpFailures,
+		traceOperations, applicationReconciled, applicationDesiredReplicas, applicationReadyReplicas, leader)
+}
+
+// UnregisterWorkqueueMetrics removes the metrics of the workqueues of the controllers from the registry. The workqueues
+// of a restarted manager can't register their metrics otherwise, as controller-runtime drops the metrics of a workqueue
+// whose name is already registered. A collector is unregistered by its name and constant labels, whatever its type.
+func UnregisterWorkqueueMetrics() {
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		return
+	}
+	for _, f := range families {
+		if !strings.HasPrefix(f.GetName(), "workqueue_") {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := prometheus.Labels{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			metrics.Registry.Unregister(prometheus.NewGauge(prometheus.GaugeOpts{Name: f.GetName(), Help: f.GetHelp(), ConstLabels: labels}))
+		}
+	}
+}
+
+// SetLeader records whether this replica of the operator is the leader
+func SetLeader(leading bool) {
+	if leading {
+		leader.Set(1)
+	} else {
+		leader.Set(0)
+	}
+}
+
+// RecordReconcile records the result of a reconciliation. An empty reason is reported for successful results.
+func RecordReconcile(kind, result, reason string, duration time.Duration) {
+	reconcileTotal.WithLabelValues(kind, result, reason).Inc()
+	reconcileDuration.WithLabelValues(kind).Observe(duration.Seconds())
+}
+
+// RecordApplicationReconcile records the reconciliation of an OpenLibertyApplication from its Reconciled condition
+func RecordApplicationReconcile(la *openlibertyv1beta1.OpenLibertyApplication, duration time.Duration) {
+	result, reason, reconciled := MetricsResultError, "Unknown", 0.0
+	if c := la.Status.GetCondition(common.StatusConditionTypeReconciled); c != nil {
+		if c.GetStatus() == corev1.ConditionTrue {
+			result, reason, reconciled = MetricsResultSuccess, "", 1
+		} else if c.GetReason() != "" {
+			reason = c.GetReason()
+		}
+	}
+	RecordReconcile("OpenLibertyApplication", result, reason, duration)
+	applicationReconciled.WithLabelValues(la.Namespace, la.Name).Set(reconciled)
+}
+
+// SetApplicationReplicas records the desired and ready replicas of an OpenLibertyApplication. The workload
+// defaults to one replica when it doesn't set any.
+func SetApplicationReplicas(la *openlibertyv1beta1.OpenLibertyApplication, desired *int32, ready int32) {
+	d := int32(1)
+	if desired != nil {
+		d = *desired
+	}
+	applicationDesiredReplicas.WithLabelValues(la.Namespace, la.Name).Set(float64(d))
+	applicationReadyReplicas.WithLabelValues(la.Namespace, la.Name).Set(float64(ready))
+}
+
+// DeleteApplicationReplicas removes the replica metrics of an OpenLibertyApplication that isn't run by a
+// Deployment or StatefulSet
+func DeleteApplicationReplicas(la *openlibertyv1beta1.OpenLibertyApplication) {
+	applicationDesiredReplicas.DeleteLabelValues(la.Namespace, la.Name)
+	applicationReadyReplicas.DeleteLabelValues(la.Namespace, la.Name)
+}
+
+// DeleteApplicationMetrics removes the metrics of an OpenLibertyApplication that no longer exists
+func DeleteApplicationMetrics(namespace, name string) {
+	for _, g := range []*prometheus.GaugeVec{applicationReconciled, applicationDesiredReplicas, applicationReadyReplicas} {
+		g.DeleteLabelValues(namespace, name)
+	}
+}
+
+// RecordDump records a server dump. The size of the archive is only recorded for successful dumps.
+func RecordDump(include []openlibertyv1beta1.OpenLibertyDumpInclude, duration time.Duration, size int64, err error) {
+	label := GetDumpIncludeLabel(include)
+	if err != nil {
+		dumpFailures.WithLabelValues(label).Inc()
+		return
+	}
+	dumpDuration.WithLabelValues(label).Observe(duration.Seconds())
+	if size > 0 {
+		dumpArchiveSize.WithLabelValues(label).Observe(float64(size))
+	}
+}
+
+// GetArchiveSizeCommand returns the command printing the size in bytes of a dump archive. The size is printed to the
+// standard error, which ExecuteCommandInContainer returns.
+func GetArchiveSizeCommand(dumpFileName string) []string {
+	return []string{"/bin/sh", "-c", "wc -c < " + dumpFileName + " >&2"}
+}
+
+// ParseArchiveSize returns the size printed by the command of GetArchiveSizeCommand, or 0 when it isn't a number
+func ParseArchiveSize(out string) int64 {
+	size, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return size
+}
+
+// RecordTraceOperation records the enablement or disablement of a trace
+func RecordTraceOperation(operation string, err error) {
+	result := MetricsResultSuccess
+	if err != nil {
+		result = MetricsResultError
+	}
+	traceOperations.WithLabelValues(operation, result).Inc()
+}
+
+// GetDumpIncludeLabel returns the sorted include types of a dump, or "none" when the dump includes none
+func GetDumpIncludeLabel(include []openlibertyv1beta1.OpenLibertyDumpInclude) string {
+	if len(include) == 0 {
+		return "none"
+	}
+	types := make([]string, 0, len(include))
+	seen := map[string]bool{}
+	for _, i := range include {
+		if !seen[string(i)] {
+			seen[string(i)] = true
+			types = append(types, string(i))
+		}
+	}
+	sort.Strings(types)
+	return strings.Join(types, ",")
+}
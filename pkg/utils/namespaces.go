@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	autils "github.com/appsody/appsody-operator/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WatchNamespaceSelectorEnvVar is the environment variable holding the label selector of the namespaces to watch
+// in addition to the namespaces of WATCH_NAMESPACE
+const WatchNamespaceSelectorEnvVar = "WATCH_NAMESPACE_SELECTOR"
+
+var (
+	watchNamespaces     []string
+	watchNamespacesLock sync.RWMutex
+)
+
+// SetWatchNamespaces sets the namespaces watched by the controllers, as resolved by the manager
+func SetWatchNamespaces(namespaces []string) {
+	watchNamespacesLock.Lock()
+	defer watchNamespacesLock.Unlock()
+	watchNamespaces = namespaces
+}
+
+// GetWatchNamespaces returns the namespaces watched by the controllers. A single empty namespace means all namespaces.
+// The namespaces of WATCH_NAMESPACE are returned when the manager didn't resolve them.
+func GetWatchNamespaces() ([]string, error) {
+	watchNamespacesLock.RLock()
+	defer watchNamespacesLock.RUnlock()
+	if watchNamespaces != nil {
+		return watchNamespaces, nil
+	}
+	return autils.GetWatchNamespaces()
+}
+
+// IsClusterWide returns true when the namespaces include all namespaces
+func IsClusterWide(namespaces []string) bool {
+	for _, ns := range namespaces {
+		if ns == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// GetWatchNamespaceSelector returns the label selector of the namespaces to watch, or nil when it is not set
+func GetWatchNamespaceSelector() (labels.Selector, error) {
+	s, found := os.LookupEnv(WatchNamespaceSelectorEnvVar)
+	if !found || s == "" {
+		return nil, nil
+	}
+	return labels.Parse(s)
+}
+
+// ResolveWatchNamespaces returns the sorted union of the given namespaces and the namespaces matching the selector
+func ResolveWatchNamespaces(clientset kubernetes.Interface, namespaces []string, selector labels.Selector) ([]string, error) {
+	if IsClusterWide(namespaces) {
+		return []string{""}, nil
+	}
+	resolved := map[string]bool{}
+	for _, ns := range namespaces {
+		resolved[ns] = true
+	}
+	if selector != nil {
+		list, err := clientset.CoreV1().Namespaces().List(metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range list.Items {
+			if ns.Status.Phase != corev1.NamespaceTerminating {
+				resolved[ns.Name] = true
+			}
+		}
+	}
+	return sortedNamespaces(resolved), nil
+}
+
+// WatchNamespaceChanges calls onChange once when the namespaces matching the selector no longer result in the
+// given watched namespaces
+func WatchNamespaceChanges(clientset kubernetes.Interface, namespaces []string, selector labels.Selector, watched []string, stop <-chan struct{}, onChange func([]string)) {
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, 0, informers.WithTweakListOptions(func(o *metav1.ListOptions) {
+		o.LabelSelector = selector.String()
+	}))
+	informer := factory.Core().V1().Namespaces()
+
+	var once sync.Once
+	var synced int32
+	check := func() {
+		// Namespaces are only compared once all of them are listed
+		if atomic.LoadInt32(&synced) == 0 {
+			return
+		}
+		matching, err := informer.Lister().List(labels.Everything())
+		if err != nil {
+			return
+		}
+		resolved := map[string]bool{}
+		for _, ns := range namespaces {
+			resolved[ns] = true
+		}
+		for _, ns := range matching {
+			if ns.Status.Phase != corev1.NamespaceTerminating {
+				resolved[ns.Name] = true
+			}
+		}
+		if current := sortedNamespaces(resolved); !reflect.DeepEqual(current, watched) {
+			once.Do(func() { onChange(current) })
+		}
+	}
+	informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { check() },
+		UpdateFunc: func(oldObj, newObj interface{}) { check() },
+		DeleteFunc: func(obj interface{}) { check() },
+	})
+	factory.Start(stop)
+
+	go func() {
+		if cache.WaitForCacheSync(stop, informer.Informer().HasSynced) {
+			atomic.StoreInt32(&synced, 1)
+			check()
+		}
+	}()
+}
+
+func sortedNamespaces(set map[string]bool) []string {
+	namespaces := make([]string, 0, len(set))
+	for ns := range set {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsPaused returns true when the resources of the application must not be changed
+func IsPaused(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	return la.Spec.Paused != nil && *la.Spec.Paused
+}
+
+// DriftRecorder records how the resources of a paused application differ from their desired state instead of
+// creating, updating or deleting them. It can be used in place of the ReconcilerBase for these operations.
+type DriftRecorder struct {
+	client client.Client
+	drift  []string
+}
+
+// NewDriftRecorder returns a DriftRecorder reading the resources with the given client
+func NewDriftRecorder(c client.Client) *DriftRecorder {
+	return &DriftRecorder{client: c}
+}
+
+// CreateOrUpdate records the fields of the resource that the reconcile function would change, or that the
+// resource would be created. The resource is left with its desired state.
+func (d *DriftRecorder) CreateOrUpdate(obj metav1.Object, owner metav1.Object, reconcile func() error) error {
+	runtimeObj, ok := obj.(runtime.Object)
+	if !ok {
+		return fmt.Errorf("%T is not a runtime.Object", obj)
+	}
+	ref := GetResourceReference(runtimeObj)
+	err := d.client.Get(context.TODO(), types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, runtimeObj)
+	if errors.IsNotFound(err) {
+		d.drift = append(d.drift, fmt.Sprintf("%s/%s is missing", ref.Kind, ref.Name))
+		return reconcile()
+	} else if err != nil {
+		return err
+	}
+
+	current := runtimeObj.DeepCopyObject()
+	if err := reconcile(); err != nil {
+		return err
+	}
+	fields, err := GetDriftedFields(current, runtimeObj)
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		d.drift = append(d.drift, fmt.Sprintf("%s/%s differs in %s", ref.Kind, ref.Name, strings.Join(fields, ", ")))
+	}
+	return nil
+}
+
+// DeleteResource records that the resource would be deleted when it exists
+func (d *DriftRecorder) DeleteResource(obj runtime.Object) error {
+	m, ok := obj.(metav1.Object)
+	if !ok {
+		return fmt.Errorf("%T is not a metav1.Object", obj)
+	}
+	ref := GetResourceReference(obj)
+	err := d.client.Get(context.TODO(), types.NamespacedName{Name: m.GetName(), Namespace: m.GetNamespace()}, obj)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	d.drift = append(d.drift, fmt.Sprintf("%s/%s is not needed", ref.Kind, ref.Name))
+	return nil
+}
+
+// DeleteResources records the resources that would be deleted
+func (d *DriftRecorder) DeleteResources(resources []runtime.Object) error {
+	for i := range resources {
+		if err := d.DeleteResource(resources[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDrift returns the recorded differences
+func (d *DriftRecorder) GetDrift() []string {
+	return d.drift
+}
+
+// GetDriftedFields returns the sorted paths of the fields that differ between the current and the desired state of
+// a resource. Only the fields set in the desired state are compared, so that the values defaulted by the API server
+// are not reported. Only the labels and annotations of the metadata are compared, and the status is ignored.
+func GetDriftedFields(current, desired runtime.Object) ([]string, error) {
+	c, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return nil, err
+	}
+	d, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range []map[string]interface{}{c, d} {
+		delete(u, "status")
+		if m, ok := u["metadata"].(map[string]interface{}); ok {
+			u["metadata"] = map[string]interface{}{"labels": m["labels"], "annotations": m["annotations"]}
+		}
+	}
+
+	fields := []string{}
+	diffFields("", c, d, &fields)
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// diffFields appends the paths of the differing values. Maps are compared key by key and lists item by item,
+// ignoring the keys that are only set in the current state. Lists are reported as a whole.
+func diffFields(path string, current, desired interface{}, fields *[]string) {
+	if !isDrifted(current, desired) {
+		return
+	}
+	cm, cok := current.(map[string]interface{})
+	dm, dok := desired.(map[string]interface{})
+	if !cok || !dok {
+		*fields = append(*fields, path)
+		return
+	}
+	for k := range dm {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+		diffFields(p, cm[k], dm[k], fields)
+	}
+}
+
+// isDrifted returns true when the current value differs from the fields set in the desired value
+func isDrifted(current, desired interface{}) bool {
+	if desired == nil {
+		return false
+	}
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			return true
+		}
+		for k := range d {
+			if isDrifted(c[k], d[k]) {
+				return true
+			}
+		}
+		return false
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok || len(c) != len(d) {
+			return true
+		}
+		for i := range d {
+			if isDrifted(c[i], d[i]) {
+				return true
+			}
+		}
+		return false
+	}
+	return !reflect.DeepEqual(current, desired)
+}
+
+// SetPausedStatus sets the Paused condition from the recorded drift, or removes it when the application isn't paused
+func SetPausedStatus(la *openlibertyv1beta1.OpenLibertyApplication, drift *DriftRecorder) {
+	if drift == nil {
+		RemoveStatusCondition(la, openlibertyv1beta1.StatusConditionTypePaused)
+		return
+	}
+
+	if len(drift.GetDrift()) == 0 {
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypePaused, corev1.ConditionTrue, "NoDrift",
+			"The resources of the application match their desired state")
+		return
+	}
+	SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypePaused, corev1.ConditionTrue, "Drift",
+		strings.Join(drift.GetDrift(), "; "))
+}
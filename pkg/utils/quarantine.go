@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuarantineLabel is set on the quarantined pods to the name of their OpenLibertyQuarantine
+const QuarantineLabel = "openliberty.io/quarantine"
+
+// CustomizeQuarantinedPod takes the pod out of the selectors of the Service and the ReplicaSet of the application,
+// so that the pod no longer receives traffic and the ReplicaSet creates a replacement. The owner references are
+// replaced by the given controller, which can't be set while the ReplicaSet still controls the pod.
+func CustomizeQuarantinedPod(pod *corev1.Pod, name string, owner metav1.OwnerReference) error {
+	if ref := metav1.GetControllerOf(pod); ref != nil && ref.UID != owner.UID && ref.Kind != "ReplicaSet" {
+		return fmt.Errorf("Pod %s is controlled by %s %s. Only the pods of a Deployment can be quarantined", pod.Name, ref.Kind, ref.Name)
+	}
+
+	delete(pod.Labels, "app.kubernetes.io/instance")
+	delete(pod.Labels, "pod-template-hash")
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[QuarantineLabel] = name
+	pod.OwnerReferences = []metav1.OwnerReference{owner}
+	return nil
+}
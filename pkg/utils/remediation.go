@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultRemediationPeriod      = 5 * time.Minute
+	defaultRemediationMinInterval = 10 * time.Minute
+	// RemediationDumpTimeout is the time after which the action of a remediation runs when its dump didn't complete
+	RemediationDumpTimeout = 10 * time.Minute
+	// remediationHistoryLimit is the number of remediations kept in the status
+	remediationHistoryLimit = 10
+)
+
+// IsRemediationEnabled returns true when the application has remediation rules and its logs are followed
+func IsRemediationEnabled(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	r := la.GetRemediation()
+	return IsLogWatcherEnabled(la) && r != nil && len(r.Rules) > 0
+}
+
+// GetRemediationMessageIDs returns the IDs of the messages counted by the remediation rules
+func GetRemediationMessageIDs(la *openlibertyv1beta1.OpenLibertyApplication) map[string]bool {
+	ids := map[string]bool{}
+	if IsRemediationEnabled(la) {
+		for _, rule := range la.GetRemediation().Rules {
+			ids[rule.MessageID] = true
+		}
+	}
+	return ids
+}
+
+// GetRemediationRetention returns how long the messages counted by the rules are kept, which is the longest period
+func GetRemediationRetention(la *openlibertyv1beta1.OpenLibertyApplication) time.Duration {
+	retention := time.Duration(0)
+	if IsRemediationEnabled(la) {
+		for i := range la.GetRemediation().Rules {
+			if p := GetRemediationRulePeriod(&la.GetRemediation().Rules[i]); p > retention {
+				retention = p
+			}
+		}
+	}
+	return retention
+}
+
+// GetRemediationRulePeriod returns the period the messages of the rule are counted in
+func GetRemediationRulePeriod(rule *openlibertyv1beta1.OpenLibertyApplicationRemediationRule) time.Duration {
+	if d, err := time.ParseDuration(rule.Period); err == nil && d > 0 {
+		return d
+	}
+	return defaultRemediationPeriod
+}
+
+// GetRemediationRuleThreshold returns the number of messages that triggers the rule
+func GetRemediationRuleThreshold(rule *openlibertyv1beta1.OpenLibertyApplicationRemediationRule) int32 {
+	if rule.Threshold != nil && *rule.Threshold > 0 {
+		return *rule.Threshold
+	}
+	return 1
+}
+
+// GetRemediationRuleAction returns the action run by the rule
+func GetRemediationRuleAction(rule *openlibertyv1beta1.OpenLibertyApplicationRemediationRule) openlibertyv1beta1.OpenLibertyApplicationRemediationAction {
+	if rule.Action == "" {
+		return openlibertyv1beta1.RemediationActionDelete
+	}
+	return rule.Action
+}
+
+// GetRemediationMaxConcurrent returns the maximum number of pods of the application remediated at the same time
+func GetRemediationMaxConcurrent(la *openlibertyv1beta1.OpenLibertyApplication) int {
+	if r := la.GetRemediation(); r != nil && r.MaxConcurrent != nil && *r.MaxConcurrent > 0 {
+		return int(*r.MaxConcurrent)
+	}
+	return 1
+}
+
+// GetRemediationMinInterval returns the minimum time between the start of two remediations of the application
+func GetRemediationMinInterval(la *openlibertyv1beta1.OpenLibertyApplication) time.Duration {
+	if r := la.GetRemediation(); r != nil {
+		if d, err := time.ParseDuration(r.MinInterval); err == nil && d >= 0 {
+			return d
+		}
+	}
+	return defaultRemediationMinInterval
+}
+
+// CountMessages returns the number of messages logged within the period before now
+func CountMessages(times []time.Time, now time.Time, period time.Duration) int32 {
+	count := int32(0)
+	for _, t := range times {
+		if !t.Before(now.Add(-period)) && !t.After(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// GetRemediationDumpName returns the name of the OpenLibertyDump taken by the rule on the pod
+func GetRemediationDumpName(podName, rule string) string {
+	return podName + "-" + rule
+}
+
+// GetRemediationLimit returns why a new remediation of the application can't start now, or an empty string when it
+// can. Remediations are limited to a number running at the same time and to one per minimum interval.
+func GetRemediationLimit(la *openlibertyv1beta1.OpenLibertyApplication, now time.Time) string {
+	active := 0
+	var lastStart *metav1.Time
+	for i := range la.Status.Remediations {
+		rec := &la.Status.Remediations[i]
+		if rec.Phase == openlibertyv1beta1.RemediationPhaseDumping {
+			active++
+		}
+		if lastStart == nil || lastStart.Before(&rec.StartTime) {
+			lastStart = &rec.StartTime
+		}
+	}
+	if max := GetRemediationMaxConcurrent(la); active >= max {
+		return fmt.Sprintf("%d remediations are already running", active)
+	}
+	if interval := GetRemediationMinInterval(la); lastStart != nil && now.Sub(lastStart.Time) < interval {
+		return "the last remediation started less than " + interval.String() + " ago"
+	}
+	return ""
+}
+
+// FindRemediation returns the remediation of the pod by the rule, or nil
+func FindRemediation(la *openlibertyv1beta1.OpenLibertyApplication, podName, rule string) *openlibertyv1beta1.OpenLibertyApplicationRemediationRecord {
+	for i := range la.Status.Remediations {
+		if rec := &la.Status.Remediations[i]; rec.PodName == podName && rec.Rule == rule {
+			return rec
+		}
+	}
+	return nil
+}
+
+// AddRemediation adds the remediation to the status, dropping the oldest remediations that completed beyond the
+// history limit
+func AddRemediation(la *openlibertyv1beta1.OpenLibertyApplication, rec openlibertyv1beta1.OpenLibertyApplicationRemediationRecord) {
+	records := append(la.Status.Remediations, rec)
+	for excess := len(records) - remediationHistoryLimit; excess > 0; excess-- {
+		i := 0
+		for i < len(records) && records[i].Phase == openlibertyv1beta1.RemediationPhaseDumping {
+			i++
+		}
+		if i == len(records) {
+			break
+		}
+		records = append(records[:i], records[i+1:]...)
+	}
+	la.Status.Remediations = records
+}
+
+// SetRemediationCompleted records the result of the action of the remediation
+func SetRemediationCompleted(rec *openlibertyv1beta1.OpenLibertyApplicationRemediationRecord, err error, message string) {
+	now := metav1.Now()
+	rec.CompletionTime = &now
+	if err != nil {
+		rec.Phase, rec.Message = openlibertyv1beta1.RemediationPhaseFailed, err.Error()
+		return
+	}
+	rec.Phase, rec.Message = openlibertyv1beta1.RemediationPhaseCompleted, message
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServingConditionType is the readiness gate of the pods that can be paused. The condition is False while the
+// server of the pod is paused by an OpenLibertyPause.
+const ServingConditionType corev1.PodConditionType = "openliberty.io/serving"
+
+// CustomizePauseReadinessGate adds the readiness gate reporting whether the server of the pod is paused
+func CustomizePauseReadinessGate(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	if la.Spec.PauseReadinessGate != nil && *la.Spec.PauseReadinessGate {
+		pts.Spec.ReadinessGates = []corev1.PodReadinessGate{{ConditionType: ServingConditionType}}
+	} else {
+		pts.Spec.ReadinessGates = nil
+	}
+}
+
+// HasServingReadinessGate returns true when the readiness of the pod depends on whether its server is paused
+func HasServingReadinessGate(pod *corev1.Pod) bool {
+	for _, g := range pod.Spec.ReadinessGates {
+		if g.ConditionType == ServingConditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// GetServingCondition returns the condition of the readiness gate, or nil when it isn't set yet
+func GetServingCondition(pod *corev1.Pod) *corev1.PodCondition {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == ServingConditionType {
+			return &pod.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetServingCondition sets the condition of the readiness gate and returns true when it changed
+func SetServingCondition(pod *corev1.Pod, status corev1.ConditionStatus, reason, message string) bool {
+	now := metav1.Now()
+	if c := GetServingCondition(pod); c != nil {
+		if c.Status == status && c.Reason == reason && c.Message == message {
+			return false
+		}
+		if c.Status != status {
+			c.LastTransitionTime = now
+		}
+		c.Status, c.Reason, c.Message = status, reason, message
+		return true
+	}
+	pod.Status.Conditions = append(pod.Status.Conditions, corev1.PodCondition{
+		Type:               ServingConditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: now,
+	})
+	return true
+}
+
+// ProbeEndpoint is the endpoint of the server serving the health checks of the probes
+const ProbeEndpoint = "defaultHttpEndpoint"
+
+// GetServerPauseCommand returns the shell command pausing or resuming the targets of the server, or all of them.
+// Pausing all of them keeps the endpoint of the probes running when the pod has a liveness probe, since the kubelet
+// would otherwise restart the paused container.
+func GetServerPauseCommand(action string, targets []string, pod *corev1.Pod) string {
+	if len(targets) > 0 {
+		return "server " + action + " --target=" + strings.Join(targets, ",")
+	}
+	if action == "pause" && hasEndpointLivenessProbe(pod) {
+		return "server pause && server resume --target=" + ProbeEndpoint
+	}
+	return "server " + action
+}
+
+// hasEndpointLivenessProbe returns true when the liveness probe of the Liberty container connects to the server
+func hasEndpointLivenessProbe(pod *corev1.Pod) bool {
+	name := GetLibertyContainerName(pod)
+	for _, c := range pod.Spec.Containers {
+		if c.Name == name {
+			return c.LivenessProbe != nil && (c.LivenessProbe.HTTPGet != nil || c.LivenessProbe.TCPSocket != nil)
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	sessionCacheConfigMountPath   = "/config/sessioncache"
+	sessionCacheDropinMountPath   = "/config/configDropins/overrides/sessioncache.xml"
+	sessionCacheLibraryVolumeName = "sessioncache-lib"
+	sessionCacheConfigVolumeName  = "sessioncache-config"
+	sessionCacheHashAnnotation    = "openliberty.io/session-cache-config-hash"
+)
+
+// GetSessionCacheConfigMapName returns the name of the ConfigMap holding the session cache configuration
+func GetSessionCacheConfigMapName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	return la.Name + "-sessioncache"
+}
+
+// CustomizeSessionCacheConfigMap renders the session cache configDropin and JCache provider configuration
+func CustomizeSessionCacheConfigMap(cm *corev1.ConfigMap, la *openlibertyv1beta1.OpenLibertyApplication) {
+	cm.Labels = la.GetLabels()
+	cm.Annotations = la.GetAnnotations()
+	cm.Data = renderSessionCacheConfig(la)
+}
+
+// ConfigureSessionCache mounts the session cache configuration and libraries into the application container
+func ConfigureSessionCache(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	sc := la.GetSessionCache()
+	if sc == nil {
+		delete(pts.Annotations, sessionCacheHashAnnotation)
+		pts.Spec.InitContainers = removeContainer(pts.Spec.InitContainers, sessionCacheLibraryVolumeName)
+		return
+	}
+
+	// The JCache provider configuration is read from the whole ConfigMap
+	pts.Spec.Containers[0].VolumeMounts = append(pts.Spec.Containers[0].VolumeMounts,
+		corev1.VolumeMount{Name: sessionCacheConfigVolumeName, MountPath: sessionCacheConfigMountPath, ReadOnly: true})
+	mountConfigDropin(pts, sessionCacheConfigVolumeName, GetSessionCacheConfigMapName(la), "sessioncache.xml",
+		sessionCacheDropinMountPath, sessionCacheHashAnnotation, renderSessionCacheConfig(la))
+
+	if sc.LibraryImage != "" {
+		pts.Spec.Volumes = append(pts.Spec.Volumes, corev1.Volume{
+			Name:         sessionCacheLibraryVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+		pts.Spec.Containers[0].VolumeMounts = append(pts.Spec.Containers[0].VolumeMounts,
+			corev1.VolumeMount{Name: sessionCacheLibraryVolumeName, MountPath: sc.GetLibraryPath(), ReadOnly: true})
+
+		initContainer := corev1.Container{
+			Name:    sessionCacheLibraryVolumeName,
+			Image:   sc.LibraryImage,
+			Command: []string{"/bin/sh", "-c", "cp -R " + sc.GetLibraryPath() + "/. /" + sessionCacheLibraryVolumeName + "/"},
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: sessionCacheLibraryVolumeName, MountPath: "/" + sessionCacheLibraryVolumeName},
+			},
+		}
+		pts.Spec.InitContainers = append(removeContainer(pts.Spec.InitContainers, sessionCacheLibraryVolumeName), initContainer)
+	} else {
+		pts.Spec.InitContainers = removeContainer(pts.Spec.InitContainers, sessionCacheLibraryVolumeName)
+	}
+
+	if sc.CredentialsSecret != "" {
+		for _, key := range []string{"username", "password"} {
+			pts.Spec.Containers[0].Env = append(pts.Spec.Containers[0].Env, corev1.EnvVar{
+				Name: "SESSION_CACHE_" + strings.ToUpper(key),
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: sc.CredentialsSecret},
+						Key:                  key,
+					},
+				},
+			})
+		}
+	}
+
+	if sc.GetProvider() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheProviderHazelcast &&
+		sc.GetMode() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeClient {
+		// Hazelcast selects its client caching provider through a system property only
+		addJVMArg(pts, "-Dhazelcast.jcache.provider.type=client")
+	}
+}
+
+// GetSessionCacheServiceDNS returns the DNS name of the headless service used to discover cache members
+func GetSessionCacheServiceDNS(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	return la.Name + "-headless." + la.Namespace + ".svc"
+}
+
+// IsSessionCacheEmbedded returns true when the pods of the application form the session cache cluster
+func IsSessionCacheEmbedded(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	return la.GetSessionCache() != nil && la.GetSessionCache().GetMode() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeEmbedded
+}
+
+func renderSessionCacheConfig(la *openlibertyv1beta1.OpenLibertyApplication) map[string]string {
+	sc := la.GetSessionCache()
+	clusterName := sc.ClusterName
+	if clusterName == "" {
+		clusterName = la.Name
+	}
+
+	data := map[string]string{}
+	var uri, properties string
+	switch {
+	case sc.GetProvider() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheProviderInfinispan && sc.GetMode() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeClient:
+		properties = fmt.Sprintf("\n    <properties infinispan.client.hotrod.server_list=\"%s\"", xmlEscape(strings.Join(sc.ServerAddresses, ";")))
+		if sc.CredentialsSecret != "" {
+			properties += " infinispan.client.hotrod.auth_username=\"${env.SESSION_CACHE_USERNAME}\"" +
+				" infinispan.client.hotrod.auth_password=\"${env.SESSION_CACHE_PASSWORD}\"" +
+				" infinispan.client.hotrod.auth_realm=\"default\"" +
+				" infinispan.client.hotrod.sasl_mechanism=\"DIGEST-MD5\""
+		}
+		properties += "/>\n  "
+	case sc.GetProvider() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheProviderInfinispan:
+		uri = sessionCacheConfigMountPath + "/infinispan.xml"
+		data["infinispan.xml"] = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<infinispan>
+  <jgroups>
+    <stack name="dns-ping" extends="kubernetes">
+      <dns.DNS_PING dns_query="%s" stack.combine="REPLACE" stack.position="dns.DNS_PING"/>
+    </stack>
+  </jgroups>
+  <cache-container>
+    <transport cluster="%s" stack="dns-ping"/>
+  </cache-container>
+</infinispan>
+`, xmlEscape(GetSessionCacheServiceDNS(la)), xmlEscape(clusterName))
+	case sc.GetMode() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeClient:
+		uri = sessionCacheConfigMountPath + "/hazelcast-client.xml"
+		var members bytes.Buffer
+		for _, address := range sc.ServerAddresses {
+			fmt.Fprintf(&members, "      <address>%s</address>\n", xmlEscape(address))
+		}
+		data["hazelcast-client.xml"] = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<hazelcast-client xmlns="http://www.hazelcast.com/schema/client-config">
+  <group>
+    <name>%s</name>
+  </group>
+  <network>
+    <cluster-members>
+%s    </cluster-members>
+  </network>
+</hazelcast-client>
+`, xmlEscape(clusterName), members.String())
+	default:
+		uri = sessionCacheConfigMountPath + "/hazelcast.xml"
+		data["hazelcast.xml"] = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<hazelcast xmlns="http://www.hazelcast.com/schema/config">
+  <group>
+    <name>%s</name>
+  </group>
+  <network>
+    <join>
+      <multicast enabled="false"/>
+      <kubernetes enabled="true">
+        <service-dns>%s</service-dns>
+      </kubernetes>
+    </join>
+  </network>
+</hazelcast>
+`, xmlEscape(clusterName), xmlEscape(GetSessionCacheServiceDNS(la)))
+	}
+
+	uriAttr := ""
+	if uri != "" {
+		uriAttr = fmt.Sprintf(" uri=\"file:%s\"", uri)
+	}
+	data["sessioncache.xml"] = fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<server>
+  <featureManager>
+    <feature>sessionCache-1.0</feature>
+  </featureManager>
+  <library id="SessionCacheLib">
+    <fileset dir="%s" includes="*.jar"/>
+  </library>
+  <httpSessionCache libraryRef="SessionCacheLib"%s>%s</httpSessionCache>
+</server>
+`, xmlEscape(sc.GetLibraryPath()), uriAttr, properties)
+
+	return data
+}
+
+// addJVMArg appends an argument to the JVM_ARGS environment variable of the application container
+func addJVMArg(pts *corev1.PodTemplateSpec, arg string) {
+	env, found := findEnvVar("JVM_ARGS", pts.Spec.Containers[0].Env)
+	if !found {
+		pts.Spec.Containers[0].Env = append(pts.Spec.Containers[0].Env, corev1.EnvVar{Name: "JVM_ARGS", Value: arg})
+		return
+	}
+	if env.ValueFrom == nil && !strings.Contains(env.Value, arg) {
+		// Copy the slice so that the application spec isn't modified
+		envList := append([]corev1.EnvVar{}, pts.Spec.Containers[0].Env...)
+		env, _ = findEnvVar("JVM_ARGS", envList)
+		env.Value = strings.TrimSpace(env.Value + " " + arg)
+		pts.Spec.Containers[0].Env = envList
+	}
+}
+
+// removeContainer returns the list of containers without the named container
+func removeContainer(containers []corev1.Container, name string) []corev1.Container {
+	for i, c := range containers {
+		if c.Name == name {
+			return append(append([]corev1.Container{}, containers[:i]...), containers[i+1:]...)
+		}
+	}
+	return containers
+}
+
+// hashData returns a stable hash of the given data
+func hashData(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\n", k, data[k])
+	}
+	return fmt.Sprintf("%x", h.Sum(nil))[:16]
+}
+
+func xmlEscape(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// Routers take longer than kube-proxy to remove the endpoints of a terminating pod
+	defaultExposedDrainDelay = 10 * time.Second
+	defaultDrainDelay        = 5 * time.Second
+	// Liberty waits up to 30 seconds for the requests in progress when the server stops
+	defaultStopTimeout = 30 * time.Second
+	// Time left for the container to exit once the server stopped
+	shutdownGracePeriodMargin = 5 * time.Second
+)
+
+// GetShutdownSettings returns the drain delay, the stop timeout and the termination grace period of the pods,
+// with the defaults of the fields that are not set
+func GetShutdownSettings(la *openlibertyv1beta1.OpenLibertyApplication) (time.Duration, time.Duration, int64) {
+	drain, stop := defaultDrainDelay, defaultStopTimeout
+	if la.Spec.Expose != nil && *la.Spec.Expose {
+		drain = defaultExposedDrainDelay
+	}
+
+	var gracePeriod *int64
+	if shutdown := la.GetShutdown(); shutdown != nil {
+		if d, err := time.ParseDuration(shutdown.DrainDelay); err == nil {
+			drain = d
+		}
+		if d, err := time.ParseDuration(shutdown.StopTimeout); err == nil {
+			stop = d
+		}
+		gracePeriod = shutdown.TerminationGracePeriodSeconds
+	}
+
+	if gracePeriod != nil {
+		return drain, stop, *gracePeriod
+	}
+	return drain, stop, int64(math.Ceil((drain + stop + shutdownGracePeriodMargin).Seconds()))
+}
+
+// CustomizeShutdown lets the pods drain their connections before the server stops. The preStop hook of the
+// application container waits for the drain delay, so that the pod is removed from the endpoints first, and then
+// stops the server, which completes the requests in progress within its own quiesce period of 30 seconds. The stop
+// timeout only budgets the termination grace period, after which the pods are killed.
+func CustomizeShutdown(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	drain, stop, gracePeriod := GetShutdownSettings(la)
+	pts.Spec.TerminationGracePeriodSeconds = &gracePeriod
+
+	container := &pts.Spec.Containers[0]
+	if drain <= 0 && stop <= 0 {
+		container.Lifecycle = nil
+		return
+	}
+
+	cmd := fmt.Sprintf("sleep %d", int64(math.Ceil(drain.Seconds())))
+	if stop > 0 {
+		// The hook fails when the server is already stopped, which doesn't prevent the pod from terminating
+		cmd += " && server stop"
+	}
+	container.Lifecycle = &corev1.Lifecycle{
+		PreStop: &corev1.Handler{
+			Exec: &corev1.ExecAction{Command: []string{"/bin/sh", "-c", cmd}},
+		},
+	}
+}
@@ -0,0 +1,232 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/discovery"
+)
+
+const (
+	ssoDropinMountPath = "/config/configDropins/overrides/sso.xml"
+	ssoVolumeName      = "sso-config"
+	ssoHashAnnotation  = "openliberty.io/sso-config-hash"
+
+	// SSOServiceAccountRedirectAnnotation registers the Route of the application as a redirect URI of its service account
+	SSOServiceAccountRedirectAnnotation = "serviceaccounts.openshift.io/oauth-redirectreference.openliberty"
+)
+
+// SSOSettings holds the values discovered from the cluster that are needed to configure single sign-on
+type SSOSettings struct {
+	// Scheme, host and port used to build the redirect URLs, e.g. https://my-app.apps.example.com
+	RedirectToRPHostAndPort string
+	// Endpoints of the OpenShift OAuth server
+	OpenShiftAuthorizationEndpoint string
+	OpenShiftTokenEndpoint         string
+	// Secret holding the token of the service account of the application, used to call the TokenReview API and
+	// as the client secret when the service account is the OpenShift OAuth client
+	ServiceAccountTokenSecret string
+}
+
+var nonAlphanumeric = regexp.MustCompile("[^A-Z0-9]+")
+
+// GetSSOConfigMapName returns the name of the ConfigMap holding the single sign-on configuration
+func GetSSOConfigMapName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	return la.Name + "-sso"
+}
+
+// CustomizeSSOConfigMap renders the social login configDropin
+func CustomizeSSOConfigMap(cm *corev1.ConfigMap, la *openlibertyv1beta1.OpenLibertyApplication, settings SSOSettings) {
+	cm.Labels = la.GetLabels()
+	cm.Annotations = la.GetAnnotations()
+	cm.Data = map[string]string{"sso.xml": renderSSOConfig(la, settings)}
+}
+
+// ConfigureSSO mounts the single sign-on configuration and exposes the client credentials to the application container
+func ConfigureSSO(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication, settings SSOSettings) {
+	sso := la.GetSSO()
+	if sso == nil {
+		delete(pts.Annotations, ssoHashAnnotation)
+		return
+	}
+
+	mountConfigDropin(pts, ssoVolumeName, GetSSOConfigMapName(la), "sso.xml", ssoDropinMountPath,
+		ssoHashAnnotation, map[string]string{"sso.xml": renderSSOConfig(la, settings)})
+
+	addSecretEnv := func(id, suffix string, sel *corev1.SecretKeySelector) {
+		if sel == nil {
+			return
+		}
+		pts.Spec.Containers[0].Env = append(pts.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:      ssoEnvName(id, suffix),
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: sel.DeepCopy()},
+		})
+	}
+	for i := range sso.OIDC {
+		addSecretEnv(sso.OIDC[i].ID, "CLIENT_ID", &sso.OIDC[i].ClientID)
+		addSecretEnv(sso.OIDC[i].ID, "CLIENT_SECRET", &sso.OIDC[i].ClientSecret)
+	}
+	if sso.GitHub != nil {
+		addSecretEnv("github", "CLIENT_ID", &sso.GitHub.ClientID)
+		addSecretEnv("github", "CLIENT_SECRET", &sso.GitHub.ClientSecret)
+	}
+	if sso.Google != nil {
+		addSecretEnv("google", "CLIENT_ID", &sso.Google.ClientID)
+		addSecretEnv("google", "CLIENT_SECRET", &sso.Google.ClientSecret)
+	}
+	if sso.OpenShift != nil {
+		token := &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: settings.ServiceAccountTokenSecret},
+			Key:                  "token",
+		}
+		addSecretEnv("openshift", "USER_API_TOKEN", token)
+		if IsSSOServiceAccountClient(la) {
+			addSecretEnv("openshift", "CLIENT_SECRET", token)
+		} else {
+			addSecretEnv("openshift", "CLIENT_ID", sso.OpenShift.ClientID)
+			addSecretEnv("openshift", "CLIENT_SECRET", sso.OpenShift.ClientSecret)
+		}
+	}
+}
+
+// IsSSOServiceAccountClient returns true when the service account of the application is used as the OpenShift OAuth client
+func IsSSOServiceAccountClient(la *openlibertyv1beta1.OpenLibertyApplication) bool {
+	return la.GetSSO() != nil && la.GetSSO().OpenShift != nil && la.GetSSO().OpenShift.ClientID == nil
+}
+
+// GetSSOServiceAccountRedirectReference returns the redirect reference to the Route of the application
+// to be used as the value of the SSOServiceAccountRedirectAnnotation annotation
+func GetSSOServiceAccountRedirectReference(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	ref := map[string]interface{}{
+		"kind":       "OAuthRedirectReference",
+		"apiVersion": "v1",
+		"reference":  map[string]string{"kind": "Route", "name": la.Name},
+	}
+	data, _ := json.Marshal(ref)
+	return string(data)
+}
+
+// CustomizeSSOServiceAccountRedirect adds the redirect reference to the Route of the application to its service account
+// when the service account is the OpenShift OAuth client, or removes it otherwise. It returns true when the annotations
+// changed. A service account that redirects to another application can't be the OAuth client of this one.
+func CustomizeSSOServiceAccountRedirect(sa *corev1.ServiceAccount, la *openlibertyv1beta1.OpenLibertyApplication) (bool, error) {
+	ref := GetSSOServiceAccountRedirectReference(la)
+	current, ok := sa.Annotations[SSOServiceAccountRedirectAnnotation]
+	if ok && current != ref {
+		if IsSSOServiceAccountClient(la) {
+			return false, fmt.Errorf("Service account %s already redirects to another application, set sso.openShift.clientId and sso.openShift.clientSecret or use another service account", sa.Name)
+		}
+		return false, nil
+	}
+
+	if IsSSOServiceAccountClient(la) && la.Spec.Expose != nil && *la.Spec.Expose {
+		if ok {
+			return false, nil
+		}
+		if sa.Annotations == nil {
+			sa.Annotations = map[string]string{}
+		}
+		sa.Annotations[SSOServiceAccountRedirectAnnotation] = ref
+		return true, nil
+	}
+	delete(sa.Annotations, SSOServiceAccountRedirectAnnotation)
+	return ok, nil
+}
+
+// GetServiceAccountTokenSecret returns the name of the token secret of a service account
+func GetServiceAccountTokenSecret(sa *corev1.ServiceAccount) string {
+	for _, s := range sa.Secrets {
+		if strings.HasPrefix(s.Name, sa.Name+"-token-") {
+			return s.Name
+		}
+	}
+	return ""
+}
+
+// GetOpenShiftOAuthEndpoints returns the authorization and token endpoints of the OpenShift OAuth server
+func GetOpenShiftOAuthEndpoints(dc discovery.DiscoveryInterface) (string, string, error) {
+	if dc == nil || dc.RESTClient() == nil {
+		return "", "", fmt.Errorf("REST client is not available to discover the OpenShift OAuth server")
+	}
+	data, err := dc.RESTClient().Get().AbsPath("/.well-known/oauth-authorization-server").DoRaw()
+	if err != nil {
+		return "", "", fmt.Errorf("Failed to discover the OpenShift OAuth server: %v", err)
+	}
+	metadata := struct {
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+	}{}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return "", "", fmt.Errorf("Failed to parse the OpenShift OAuth server metadata: %v", err)
+	}
+	return metadata.AuthorizationEndpoint, metadata.TokenEndpoint, nil
+}
+
+func ssoEnvName(id, suffix string) string {
+	return "SSO_" + nonAlphanumeric.ReplaceAllString(strings.ToUpper(id), "_") + "_" + suffix
+}
+
+func renderSSOConfig(la *openlibertyv1beta1.OpenLibertyApplication, settings SSOSettings) string {
+	sso := la.GetSSO()
+	var b bytes.Buffer
+
+	common := func(id string) string {
+		attrs := fmt.Sprintf(` id="%s" clientId="${env.%s}" clientSecret="${env.%s}"`, id, ssoEnvName(id, "CLIENT_ID"), ssoEnvName(id, "CLIENT_SECRET"))
+		if settings.RedirectToRPHostAndPort != "" {
+			attrs += fmt.Sprintf(` redirectToRPHostAndPort="%s"`, xmlEscape(settings.RedirectToRPHostAndPort))
+		}
+		if sso.MapToUserRegistry != nil {
+			attrs += fmt.Sprintf(` mapToUserRegistry="%s"`, strconv.FormatBool(*sso.MapToUserRegistry))
+		}
+		return attrs
+	}
+
+	b.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<server>\n  <featureManager>\n    <feature>socialLogin-1.0</feature>\n  </featureManager>\n")
+	for _, oidc := range sso.OIDC {
+		fmt.Fprintf(&b, "  <oidcLogin%s discoveryEndpoint=\"%s\"", common(oidc.ID), xmlEscape(oidc.DiscoveryEndpoint))
+		if oidc.Scope != "" {
+			fmt.Fprintf(&b, " scope=\"%s\"", xmlEscape(oidc.Scope))
+		}
+		if oidc.UserNameAttribute != "" {
+			fmt.Fprintf(&b, " userNameAttribute=\"%s\"", xmlEscape(oidc.UserNameAttribute))
+		}
+		if oidc.DisplayName != "" {
+			fmt.Fprintf(&b, " displayName=\"%s\"", xmlEscape(oidc.DisplayName))
+		}
+		b.WriteString("/>\n")
+	}
+	if sso.GitHub != nil {
+		fmt.Fprintf(&b, "  <githubLogin%s/>\n", common("github"))
+	}
+	if sso.Google != nil {
+		fmt.Fprintf(&b, "  <googleLogin%s/>\n", common("google"))
+	}
+	if sso.OpenShift != nil {
+		attrs := common("openshift")
+		if IsSSOServiceAccountClient(la) {
+			clientID := "system:serviceaccount:" + la.Namespace + ":" + GetServiceAccountName(la)
+			attrs = strings.Replace(attrs, "${env."+ssoEnvName("openshift", "CLIENT_ID")+"}", clientID, 1)
+		}
+		// The access token of the user is validated with the TokenReview API, called with the service account token
+		fmt.Fprintf(&b, "  <oauth2Login%s authorizationEndpoint=\"%s\" tokenEndpoint=\"%s\" scope=\"user:info\""+
+			" userApi=\"https://kubernetes.default.svc/apis/authentication.k8s.io/v1/tokenreviews\" userApiType=\"kube\""+
+			" userApiToken=\"${env.%s}\" userNameAttribute=\"username\"/>\n",
+			attrs, xmlEscape(settings.OpenShiftAuthorizationEndpoint), xmlEscape(settings.OpenShiftTokenEndpoint), ssoEnvName("openshift", "USER_API_TOKEN"))
+	}
+	b.WriteString("</server>\n")
+	return b.String()
+}
+
+// GetServiceAccountName returns the name of the service account used by the pods of the application
+func GetServiceAccountName(la *openlibertyv1beta1.OpenLibertyApplication) string {
+	if la.GetServiceAccountName() != nil && *la.GetServiceAccountName() != "" {
+		return *la.GetServiceAccountName()
+	}
+	return la.Name
+}
@@ -0,0 +1,227 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
+	"github.com/appsody/appsody-operator/pkg/common"
+	prometheusv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SetStatusCondition sets a condition of the application. The transition time is kept when the status doesn't change.
+func SetStatusCondition(la *openlibertyv1beta1.OpenLibertyApplication, conditionType common.StatusConditionType, status corev1.ConditionStatus, reason, message string) {
+	now := metav1.Now()
+	transitionTime := &now
+	if old := la.Status.GetCondition(conditionType); old != nil && old.GetStatus() == status {
+		transitionTime = old.GetLastTransitionTime()
+	}
+
+	condition := la.Status.NewCondition()
+	condition.SetType(conditionType)
+	condition.SetStatus(status)
+	condition.SetReason(reason)
+	condition.SetMessage(message)
+	condition.SetLastTransitionTime(transitionTime)
+	condition.SetLastUpdateTime(now)
+	la.Status.SetCondition(condition)
+}
+
+// RemoveStatusCondition removes a condition of the application
+func RemoveStatusCondition(la *openlibertyv1beta1.OpenLibertyApplication, conditionType common.StatusConditionType) {
+	conditions := la.Status.Conditions[:0]
+	for _, c := range la.Status.Conditions {
+		if c.Type != conditionType {
+			conditions = append(conditions, c)
+		}
+	}
+	la.Status.Conditions = conditions
+}
+
+// SetDeploymentStatus sets the replicas and the Ready and Progressing conditions of the application from its Deployment
+func SetDeploymentStatus(la *openlibertyv1beta1.OpenLibertyApplication, deploy *appsv1.Deployment) {
+	desired := getReplicas(deploy.Spec.Replicas)
+	s := deploy.Status
+
+	stalled := ""
+	for _, c := range s.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Status == corev1.ConditionFalse && c.Reason == "ProgressDeadlineExceeded" {
+			stalled = c.Message
+		}
+	}
+	rollingOut := s.ObservedGeneration < deploy.Generation || s.UpdatedReplicas < desired || s.Replicas > s.UpdatedReplicas
+	setWorkloadStatus(la, desired, s.ReadyReplicas, s.UpdatedReplicas, rollingOut, stalled)
+}
+
+// SetStatefulSetStatus sets the replicas and the Ready and Progressing conditions of the application from its StatefulSet
+func SetStatefulSetStatus(la *openlibertyv1beta1.OpenLibertyApplication, statefulSet *appsv1.StatefulSet) {
+	desired := getReplicas(statefulSet.Spec.Replicas)
+	s := statefulSet.Status
+
+	rollingOut := s.ObservedGeneration < statefulSet.Generation || s.UpdatedReplicas < desired ||
+		(s.UpdateRevision != "" && s.CurrentRevision != s.UpdateRevision)
+	setWorkloadStatus(la, desired, s.ReadyReplicas, s.UpdatedReplicas, rollingOut, "")
+}
+
+// SetKnativeServiceStatus sets the Ready and Progressing conditions of the application from its Knative Service.
+// Knative scales the application on demand, so the replicas are not reported.
+func SetKnativeServiceStatus(la *openlibertyv1beta1.OpenLibertyApplication, ksvc *servingv1alpha1.Service) {
+	la.Status.Replicas, la.Status.ReadyReplicas = 0, 0
+
+	ready := ksvc.Status.GetCondition("Ready")
+	switch {
+	case ksvc.Status.ObservedGeneration < ksvc.Generation || ready == nil || ready.Status == corev1.ConditionUnknown:
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeReady, corev1.ConditionFalse, "RollingOut", "Knative Service is not ready yet")
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeProgressing, corev1.ConditionTrue, "RollingOut", "Knative Service is rolling out")
+	case ready.Status == corev1.ConditionTrue:
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeReady, corev1.ConditionTrue, "", "")
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeProgressing, corev1.ConditionFalse, "RolloutComplete", "")
+	default:
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeReady, corev1.ConditionFalse, ready.Reason, ready.Message)
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeProgressing, corev1.ConditionFalse, "RolloutFailed", ready.Message)
+	}
+}
+
+func setWorkloadStatus(la *openlibertyv1beta1.OpenLibertyApplication, desired, ready, updated int32, rollingOut bool, stalled string) {
+	la.Status.Replicas, la.Status.ReadyReplicas = desired, ready
+
+	if ready >= desired {
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeReady, corev1.ConditionTrue, "", "")
+	} else {
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeReady, corev1.ConditionFalse, "MinimumReplicasUnavailable",
+			fmt.Sprintf("%d of %d replicas are ready", ready, desired))
+	}
+
+	switch {
+	case stalled != "":
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeProgressing, corev1.ConditionFalse, "ProgressDeadlineExceeded", stalled)
+	case rollingOut:
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeProgressing, corev1.ConditionTrue, "RollingOut",
+			fmt.Sprintf("%d of %d replicas are updated", updated, desired))
+	default:
+		SetStatusCondition(la, openlibertyv1beta1.StatusConditionTypeProgressing, corev1.ConditionFalse, "RolloutComplete", "")
+	}
+}
+
+func getReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+// GetServiceEndpoint returns the address of the Service within the cluster
+func GetServiceEndpoint(svc *corev1.Service) openlibertyv1beta1.OpenLibertyApplicationEndpoint {
+	uri := fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+	if len(svc.Spec.Ports) > 0 {
+		uri = fmt.Sprintf("%s:%d", uri, svc.Spec.Ports[0].Port)
+	}
+	return openlibertyv1beta1.OpenLibertyApplicationEndpoint{Type: openlibertyv1beta1.OpenLibertyApplicationEndpointTypeService, URI: uri}
+}
+
+// GetRouteEndpoint returns the URL of the Route, or nil when the router didn't assign a host yet
+func GetRouteEndpoint(route *routev1.Route) *openlibertyv1beta1.OpenLibertyApplicationEndpoint {
+	if route.Spec.Host == "" {
+		return nil
+	}
+	scheme := "http"
+	if route.Spec.TLS != nil {
+		scheme = "https"
+	}
+	return &openlibertyv1beta1.OpenLibertyApplicationEndpoint{
+		Type: openlibertyv1beta1.OpenLibertyApplicationEndpointTypeRoute,
+		URI:  scheme + "://" + route.Spec.Host + route.Spec.Path,
+	}
+}
+
+// GetKnativeServiceEndpoint returns the URL of the Knative Service, or nil when it isn't assigned yet
+func GetKnativeServiceEndpoint(ksvc *servingv1alpha1.Service) *openlibertyv1beta1.OpenLibertyApplicationEndpoint {
+	if ksvc.Status.URL == nil {
+		return nil
+	}
+	return &openlibertyv1beta1.OpenLibertyApplicationEndpoint{
+		Type: openlibertyv1beta1.OpenLibertyApplicationEndpointTypeKnativeService,
+		URI:  ksvc.Status.URL.String(),
+	}
+}
+
+// GetIngressEndpoint returns the first URL of the Ingresses that route to the Service of the application
+func GetIngressEndpoint(ingresses []networkingv1beta1.Ingress, serviceName string) *openlibertyv1beta1.OpenLibertyApplicationEndpoint {
+	for _, ing := range ingresses {
+		tlsHosts := map[string]bool{}
+		for _, tls := range ing.Spec.TLS {
+			for _, h := range tls.Hosts {
+				tlsHosts[h] = true
+			}
+		}
+		for _, rule := range ing.Spec.Rules {
+			if rule.Host == "" || rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				if path.Backend.ServiceName != serviceName {
+					continue
+				}
+				scheme := "http"
+				if tlsHosts[rule.Host] {
+					scheme = "https"
+				}
+				return &openlibertyv1beta1.OpenLibertyApplicationEndpoint{
+					Type: openlibertyv1beta1.OpenLibertyApplicationEndpointTypeIngress,
+					URI:  scheme + "://" + rule.Host + strings.TrimSuffix(path.Path, "/"),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetResourceReference returns the reference of a resource created for the application
+func GetResourceReference(obj runtime.Object) openlibertyv1beta1.OpenLibertyApplicationResourceReference {
+	var gvk schema.GroupVersionKind
+	switch obj.(type) {
+	case *corev1.Service:
+		gvk = corev1.SchemeGroupVersion.WithKind("Service")
+	case *corev1.ServiceAccount:
+		gvk = corev1.SchemeGroupVersion.WithKind("ServiceAccount")
+	case *corev1.ConfigMap:
+		gvk = corev1.SchemeGroupVersion.WithKind("ConfigMap")
+	case *corev1.Secret:
+		gvk = corev1.SchemeGroupVersion.WithKind("Secret")
+	case *corev1.PersistentVolumeClaim:
+		gvk = corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim")
+	case *appsv1.Deployment:
+		gvk = appsv1.SchemeGroupVersion.WithKind("Deployment")
+	case *appsv1.StatefulSet:
+		gvk = appsv1.SchemeGroupVersion.WithKind("StatefulSet")
+	case *batchv1.Job:
+		gvk = batchv1.SchemeGroupVersion.WithKind("Job")
+	case *autoscalingv1.HorizontalPodAutoscaler:
+		gvk = autoscalingv1.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler")
+	case *routev1.Route:
+		gvk = routev1.SchemeGroupVersion.WithKind("Route")
+	case *prometheusv1.ServiceMonitor:
+		gvk = prometheusv1.SchemeGroupVersion.WithKind("ServiceMonitor")
+	case *servingv1alpha1.Service:
+		gvk = servingv1alpha1.SchemeGroupVersion.WithKind("Service")
+	default:
+		gvk = obj.GetObjectKind().GroupVersionKind()
+	}
+
+	ref := openlibertyv1beta1.OpenLibertyApplicationResourceReference{}
+	ref.APIVersion, ref.Kind = gvk.ToAPIVersionAndKind()
+	if m, ok := obj.(metav1.Object); ok {
+		ref.Name = m.GetName()
+	}
+	return ref
+}
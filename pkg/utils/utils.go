@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
 
@@ -24,6 +25,9 @@ var log = logf.Log.WithName("openliberty_utils")
 //Constant Values
 const serviceabilityMountPath = "/serviceability"
 
+// LibertyContainerName is the name of the container running the Liberty server in the pods of an application
+const LibertyContainerName = "app"
+
 // Validate if the OpenLibertyApplication is valid
 func Validate(olapp *openlibertyv1beta1.OpenLibertyApplication) (bool, error) {
 	// Serviceability validation
@@ -38,9 +42,135 @@ func Validate(olapp *openlibertyv1beta1.OpenLibertyApplication) (bool, error) {
 		}
 	}
 
+	// Startup probe validation
+	if err := ValidateStartupProbe(olapp.Spec.StartupProbe); err != nil {
+		return false, err
+	}
+
+	// Shutdown validation
+	if olapp.GetShutdown() != nil && olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+		return false, fmt.Errorf("Invalid input for Shutdown. spec.shutdown is not supported when spec.createKnativeService is enabled, as the Knative queue proxy drains the requests")
+	}
+
+	// Session cache validation
+	if sc := olapp.GetSessionCache(); sc != nil {
+		if olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+			return false, fmt.Errorf("Invalid input for SessionCache. spec.sessionCache is not supported when spec.createKnativeService is enabled")
+		}
+		if sc.GetMode() == openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeClient && len(sc.ServerAddresses) == 0 {
+			return false, fmt.Errorf("Invalid input for SessionCache. Client mode %s", requiredFieldMessage("spec.sessionCache.serverAddresses"))
+		}
+	}
+
+	// Single sign-on validation
+	if sso := olapp.GetSSO(); sso != nil {
+		if olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+			return false, fmt.Errorf("Invalid input for SSO. spec.sso is not supported when spec.createKnativeService is enabled")
+		}
+		// The environment variables of the client credentials are named after the uppercased IDs
+		ids := map[string]string{}
+		for _, id := range []string{"github", "google", "openshift"} {
+			ids[ssoEnvName(id, "")] = id
+		}
+		for _, oidc := range sso.OIDC {
+			if id, ok := ids[ssoEnvName(oidc.ID, "")]; ok {
+				if id == "github" || id == "google" || id == "openshift" {
+					return false, fmt.Errorf("Invalid input for SSO. spec.sso.oidc[].id '%s' is reserved", oidc.ID)
+				}
+				return false, fmt.Errorf("Invalid input for SSO. spec.sso.oidc[].id '%s' conflicts with '%s'", oidc.ID, id)
+			}
+			ids[ssoEnvName(oidc.ID, "")] = oidc.ID
+		}
+		if sso.OpenShift != nil && (sso.OpenShift.ClientID == nil) != (sso.OpenShift.ClientSecret == nil) {
+			return false, fmt.Errorf("Invalid input for SSO. OpenShift client %s", requiredFieldMessage("spec.sso.openShift.clientId", "spec.sso.openShift.clientSecret"))
+		}
+	}
+
+	// Sidecar containers validation
+	for _, c := range olapp.GetSidecarContainers() {
+		if c.Name == LibertyContainerName {
+			return false, fmt.Errorf("Invalid input for SidecarContainers. spec.sidecarContainers[].name '%s' is reserved for the application container", c.Name)
+		}
+		if olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+			return false, fmt.Errorf("Invalid input for SidecarContainers. spec.sidecarContainers is not supported when spec.createKnativeService is enabled")
+		}
+	}
+
+	// LTPA validation
+	if olapp.GetLTPA() != nil && olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+		return false, fmt.Errorf("Invalid input for LTPA. spec.ltpa is not supported when spec.createKnativeService is enabled")
+	}
+
+	// Artifacts validation
+	for _, a := range olapp.GetArtifacts() {
+		if (a.URL == "") == (a.Maven == nil) {
+			return false, fmt.Errorf("Invalid input for Artifacts. Specify one of the following for artifact '%s': spec.artifacts[].url, spec.artifacts[].maven", a.Name)
+		}
+		if olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+			return false, fmt.Errorf("Invalid input for Artifacts. spec.artifacts is not supported when spec.createKnativeService is enabled")
+		}
+	}
+
+	// Pause readiness gate validation
+	if olapp.Spec.PauseReadinessGate != nil && *olapp.Spec.PauseReadinessGate && olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+		return false, fmt.Errorf("Invalid input for PauseReadinessGate. spec.pauseReadinessGate is not supported when spec.createKnativeService is enabled")
+	}
+
+	// Log watcher validation
+	if IsLogWatcherEnabled(olapp) && olapp.Spec.CreateKnativeService != nil && *olapp.Spec.CreateKnativeService {
+		return false, fmt.Errorf("Invalid input for LogWatcher. spec.logWatcher is not supported when spec.createKnativeService is enabled")
+	}
+	if lw := olapp.GetLogWatcher(); lw != nil && lw.FFDC != nil && lw.FFDC.Enabled != nil && *lw.FFDC.Enabled && !IsLogWatcherEnabled(olapp) {
+		return false, fmt.Errorf("Invalid input for LogWatcher. spec.logWatcher.ffdc requires spec.logWatcher.enabled")
+	}
+	if lw := olapp.GetLogWatcher(); lw != nil && lw.Inventory != nil && *lw.Inventory && !IsLogWatcherEnabled(olapp) {
+		return false, fmt.Errorf("Invalid input for LogWatcher. spec.logWatcher.inventory requires spec.logWatcher.enabled")
+	}
+	if IsFFDCCopyEnabled(olapp) && olapp.GetServiceability() == nil {
+		return false, fmt.Errorf("Invalid input for LogWatcher. spec.logWatcher.ffdc.copyToServiceability requires spec.serviceability")
+	}
+
+	// Logging validation
+	if err := validateLogging(olapp); err != nil {
+		return false, fmt.Errorf("Invalid input for Logging. %v", err)
+	}
+
+	// Remediation validation
+	if r := olapp.GetRemediation(); r != nil && len(r.Rules) > 0 {
+		if !IsLogWatcherEnabled(olapp) {
+			return false, fmt.Errorf("Invalid input for Remediation. spec.remediation requires spec.logWatcher.enabled")
+		}
+		if _, err := time.ParseDuration(r.MinInterval); r.MinInterval != "" && err != nil {
+			return false, fmt.Errorf("Invalid input for Remediation. spec.remediation.minInterval is not a valid duration: %v", err)
+		}
+		for _, rule := range r.Rules {
+			if rule.Name == "" || rule.MessageID == "" {
+				return false, fmt.Errorf("Invalid input for Remediation. %s", requiredFieldMessage("spec.remediation.rules.name", "spec.remediation.rules.messageID"))
+			}
+			if _, err := time.ParseDuration(rule.Period); rule.Period != "" && err != nil {
+				return false, fmt.Errorf("Invalid input for Remediation. The period of rule %s is not a valid duration: %v", rule.Name, err)
+			}
+			if rule.Dump != nil && olapp.GetServiceability() == nil {
+				return false, fmt.Errorf("Invalid input for Remediation. The dump of rule %s requires spec.serviceability", rule.Name)
+			}
+		}
+	}
+
 	return true, nil
 }
 
+// ValidateStartupProbe rejects the fields of a startup probe that are not used. The startup probe is emulated with an
+// initial delay of the liveness probe, so only its initialDelaySeconds, periodSeconds and failureThreshold are used.
+func ValidateStartupProbe(probe *corev1.Probe) error {
+	if probe == nil {
+		return nil
+	}
+	if probe.Exec != nil || probe.HTTPGet != nil || probe.TCPSocket != nil || probe.TimeoutSeconds != 0 || probe.SuccessThreshold != 0 {
+		return fmt.Errorf("Invalid input for StartupProbe. Only initialDelaySeconds, periodSeconds and failureThreshold are supported, as the startup probe only delays the liveness probe")
+	}
+	return nil
+}
+
 func requiredFieldMessage(fieldPaths ...string) string {
 	return "must set the field(s): " + strings.Join(fieldPaths, ",")
 }
@@ -90,11 +220,7 @@ func ExecuteCommandInContainer(config *rest.Config, podName, podNamespace, conta
 // CustomizeLibertyEnv adds configured env variables appending configured liberty settings
 func CustomizeLibertyEnv(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
 	// ENV variables have already been set, check if they exist before setting defaults
-	targetEnv := []corev1.EnvVar{
-		{Name: "WLP_LOGGING_CONSOLE_LOGLEVEL", Value: "info"},
-		{Name: "WLP_LOGGING_CONSOLE_SOURCE", Value: "message,accessLog,ffdc,audit"},
-		{Name: "WLP_LOGGING_CONSOLE_FORMAT", Value: "json"},
-	}
+	targetEnv := getLoggingEnv(la)
 
 	if la.GetServiceability() != nil {
 		targetEnv = append(targetEnv,
@@ -122,6 +248,110 @@ func findEnvVar(name string, envList []corev1.EnvVar) (*corev1.EnvVar, bool) {
 	return nil, false
 }
 
+// CustomizeStartupProbe emulates the startup probe with an initial delay of the liveness probe.
+// The Kubernetes API used by the operator predates Container.StartupProbe, so the time allowed for
+// startup (initialDelaySeconds + periodSeconds * failureThreshold) is applied as the minimum
+// initialDelaySeconds of the liveness probe. The other fields of the startup probe are rejected by ValidateStartupProbe.
+func CustomizeStartupProbe(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	startup := la.GetStartupProbe()
+	liveness := pts.Spec.Containers[0].LivenessProbe
+	if startup == nil || liveness == nil {
+		return
+	}
+
+	period, failureThreshold := startup.PeriodSeconds, startup.FailureThreshold
+	if period == 0 {
+		period = 10
+	}
+	if failureThreshold == 0 {
+		failureThreshold = 3
+	}
+
+	if delay := startup.InitialDelaySeconds + period*failureThreshold; liveness.InitialDelaySeconds < delay {
+		// Copy the probe so that the application spec isn't modified
+		liveness = liveness.DeepCopy()
+		liveness.InitialDelaySeconds = delay
+		pts.Spec.Containers[0].LivenessProbe = liveness
+	}
+}
+
+// CustomizeSidecarContainers runs the sidecar containers next to the application container.
+// Sidecars are matched by name, so the containers removed from the spec are removed from the pods, and the values
+// that the API server defaulted in the current containers are kept.
+func CustomizeSidecarContainers(pts *corev1.PodTemplateSpec, la *openlibertyv1beta1.OpenLibertyApplication) {
+	current := map[string]*corev1.Container{}
+	for i := 1; i < len(pts.Spec.Containers); i++ {
+		current[pts.Spec.Containers[i].Name] = &pts.Spec.Containers[i]
+	}
+	containers := []corev1.Container{pts.Spec.Containers[0]}
+	for _, sidecar := range la.GetSidecarContainers() {
+		c := *sidecar.DeepCopy()
+		if cur, ok := current[c.Name]; ok {
+			keepContainerDefaults(&c, cur)
+		}
+		containers = append(containers, c)
+	}
+	pts.Spec.Containers = containers
+}
+
+// keepContainerDefaults copies the values that the API server defaults from the current container to the fields
+// that the desired container leaves empty
+func keepContainerDefaults(c *corev1.Container, current *corev1.Container) {
+	if c.TerminationMessagePath == "" {
+		c.TerminationMessagePath = current.TerminationMessagePath
+	}
+	if c.TerminationMessagePolicy == "" {
+		c.TerminationMessagePolicy = current.TerminationMessagePolicy
+	}
+	if c.ImagePullPolicy == "" {
+		c.ImagePullPolicy = current.ImagePullPolicy
+	}
+	for i := range c.Ports {
+		if c.Ports[i].Protocol == "" && i < len(current.Ports) && current.Ports[i].ContainerPort == c.Ports[i].ContainerPort {
+			c.Ports[i].Protocol = current.Ports[i].Protocol
+		}
+	}
+	keepProbeDefaults(c.LivenessProbe, current.LivenessProbe)
+	keepProbeDefaults(c.ReadinessProbe, current.ReadinessProbe)
+}
+
+// keepProbeDefaults copies the values that the API server defaults from the current probe to the fields that the
+// desired probe leaves empty
+func keepProbeDefaults(p *corev1.Probe, current *corev1.Probe) {
+	if p == nil || current == nil {
+		return
+	}
+	if p.TimeoutSeconds == 0 {
+		p.TimeoutSeconds = current.TimeoutSeconds
+	}
+	if p.PeriodSeconds == 0 {
+		p.PeriodSeconds = current.PeriodSeconds
+	}
+	if p.SuccessThreshold == 0 {
+		p.SuccessThreshold = current.SuccessThreshold
+	}
+	if p.FailureThreshold == 0 {
+		p.FailureThreshold = current.FailureThreshold
+	}
+	if p.HTTPGet != nil && current.HTTPGet != nil && p.HTTPGet.Scheme == "" {
+		p.HTTPGet.Scheme = current.HTTPGet.Scheme
+	}
+}
+
+// GetLibertyContainerName returns the name of the container running the Liberty server in the pod
+func GetLibertyContainerName(pod *corev1.Pod) string {
+	for _, c := range pod.Spec.Containers {
+		if c.Name == LibertyContainerName {
+			return c.Name
+		}
+	}
+	// Pods that are not managed by the operator
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return LibertyContainerName
+}
+
 // CreateServiceabilityPVC creates PersistentVolumeClaim for Serviceability
 func CreateServiceabilityPVC(instance *openlibertyv1beta1.OpenLibertyApplication) *corev1.PersistentVolumeClaim {
 	return &corev1.PersistentVolumeClaim{
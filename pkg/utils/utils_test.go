@@ -1,21 +1,35 @@
 package utils
 
 import (
+	"crypto/sha1"
+	"crypto/sha256"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	openlibertyv1beta1 "github.com/OpenLiberty/open-liberty-operator/pkg/apis/openliberty/v1beta1"
 	autils "github.com/appsody/appsody-operator/pkg/utils"
 	servingv1alpha1 "github.com/knative/serving/pkg/apis/serving/v1alpha1"
 	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/discovery"
 	fakediscovery "k8s.io/client-go/discovery/fake"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	logf "sigs.k8s.io/controller-runtime/pkg/runtime/log"
 )
@@ -86,6 +100,1359 @@ func TestCustomizeLibertyEnv(t *testing.T) {
 
 }
 
+func TestCustomizeStartupProbe(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	// Test MicroProfile Health default probes
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		Probes: &openlibertyv1beta1.OpenLibertyApplicationProbes{
+			Defaults: openlibertyv1beta1.OpenLibertyApplicationProbesDefaultsMPHealth,
+		},
+	}
+	pts := &corev1.PodTemplateSpec{}
+
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeStartupProbe(pts, openliberty)
+
+	readiness := pts.Spec.Containers[0].ReadinessProbe
+	liveness := pts.Spec.Containers[0].LivenessProbe
+	testProbes := []Test{
+		{"Readiness probe path", "/health/ready", readiness.HTTPGet.Path},
+		{"Readiness probe port", intstr.FromInt(9080), readiness.HTTPGet.Port},
+		{"Liveness probe path", "/health/live", liveness.HTTPGet.Path},
+		{"Liveness probe delayed by startup probe", int32(120), liveness.InitialDelaySeconds},
+		{"Startup probe without handler", (*corev1.HTTPGetAction)(nil), openliberty.GetStartupProbe().HTTPGet},
+	}
+	if err := verifyTests(testProbes); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test user defined probes take precedence over the defaults
+	livenessProbe := &corev1.Probe{
+		Handler: corev1.Handler{
+			HTTPGet: &corev1.HTTPGetAction{Path: "/", Port: intstr.FromInt(9080)},
+		},
+		InitialDelaySeconds: 5,
+	}
+	spec.LivenessProbe = livenessProbe
+	spec.StartupProbe = &corev1.Probe{InitialDelaySeconds: 10, PeriodSeconds: 5, FailureThreshold: 6}
+	pts = &corev1.PodTemplateSpec{}
+
+	openliberty = createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeStartupProbe(pts, openliberty)
+
+	testProbes = []Test{
+		{"Liveness probe path", "/", pts.Spec.Containers[0].LivenessProbe.HTTPGet.Path},
+		{"Liveness probe delayed by startup probe", int32(40), pts.Spec.Containers[0].LivenessProbe.InitialDelaySeconds},
+		{"Liveness probe in spec unchanged", int32(5), livenessProbe.InitialDelaySeconds},
+		{"Readiness probe default", "/health/ready", pts.Spec.Containers[0].ReadinessProbe.HTTPGet.Path},
+	}
+	if err := verifyTests(testProbes); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test no probes without the defaults mode
+	spec = openlibertyv1beta1.OpenLibertyApplicationSpec{}
+	pts = &corev1.PodTemplateSpec{}
+
+	openliberty = createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeStartupProbe(pts, openliberty)
+
+	testProbes = []Test{
+		{"No readiness probe", (*corev1.Probe)(nil), pts.Spec.Containers[0].ReadinessProbe},
+		{"No liveness probe", (*corev1.Probe)(nil), pts.Spec.Containers[0].LivenessProbe},
+	}
+	if err := verifyTests(testProbes); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test validation of the fields of the startup probe that are not used
+	spec.StartupProbe = &corev1.Probe{InitialDelaySeconds: 10, PeriodSeconds: 5, FailureThreshold: 6}
+	if _, err := Validate(createOpenLibertyApp(name, namespace, spec)); err != nil {
+		t.Fatalf("Startup probe timing was rejected: %v", err)
+	}
+	for _, probe := range []*corev1.Probe{
+		{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/health/started", Port: intstr.FromInt(9080)}}},
+		{Handler: corev1.Handler{Exec: &corev1.ExecAction{Command: []string{"true"}}}},
+		{TimeoutSeconds: 2},
+		{SuccessThreshold: 1},
+	} {
+		spec.StartupProbe = probe
+		if _, err := Validate(createOpenLibertyApp(name, namespace, spec)); err == nil {
+			t.Fatalf("Startup probe %v was not rejected", probe)
+		}
+	}
+}
+
+func TestConfigureSessionCache(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	// Test Hazelcast client mode
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		Env: []corev1.EnvVar{{Name: "JVM_ARGS", Value: "-Xmx512m"}},
+		SessionCache: &openlibertyv1beta1.OpenLibertyApplicationSessionCache{
+			Mode:            openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeClient,
+			ServerAddresses: []string{"hazelcast.cache:5701"},
+		},
+	}
+	pts := &corev1.PodTemplateSpec{}
+	cm := &corev1.ConfigMap{}
+
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	ConfigureSessionCache(pts, openliberty)
+	CustomizeSessionCacheConfigMap(cm, openliberty)
+
+	jvmArgs, _ := findEnvVar("JVM_ARGS", pts.Spec.Containers[0].Env)
+	testSessionCache := []Test{
+		{"JVM arguments", "-Xmx512m -Dhazelcast.jcache.provider.type=client", jvmArgs.Value},
+		{"JVM arguments in spec unchanged", "-Xmx512m", openliberty.Spec.Env[0].Value},
+		{"Hazelcast client config", true, strings.Contains(cm.Data["hazelcast-client.xml"], "<address>hazelcast.cache:5701</address>")},
+		{"Session cache dropin", true, strings.Contains(cm.Data["sessioncache.xml"], `uri="file:/config/sessioncache/hazelcast-client.xml"`)},
+		{"No library init container", 0, len(pts.Spec.InitContainers)},
+	}
+	if err := verifyTests(testSessionCache); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test Infinispan client mode with credentials
+	spec = openlibertyv1beta1.OpenLibertyApplicationSpec{
+		SessionCache: &openlibertyv1beta1.OpenLibertyApplicationSessionCache{
+			Provider:          openlibertyv1beta1.OpenLibertyApplicationSessionCacheProviderInfinispan,
+			Mode:              openlibertyv1beta1.OpenLibertyApplicationSessionCacheModeClient,
+			ServerAddresses:   []string{"infinispan-0:11222", "infinispan-1:11222"},
+			CredentialsSecret: "infinispan-credentials",
+		},
+	}
+	pts = &corev1.PodTemplateSpec{}
+	cm = &corev1.ConfigMap{}
+
+	openliberty = createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	ConfigureSessionCache(pts, openliberty)
+	CustomizeSessionCacheConfigMap(cm, openliberty)
+
+	_, found := findEnvVar("SESSION_CACHE_PASSWORD", pts.Spec.Containers[0].Env)
+	testSessionCache = []Test{
+		{"Credentials environment variable", true, found},
+		{"Infinispan server list", true, strings.Contains(cm.Data["sessioncache.xml"], `infinispan.client.hotrod.server_list="infinispan-0:11222;infinispan-1:11222"`)},
+		{"Infinispan config files", 1, len(cm.Data)},
+	}
+	if err := verifyTests(testSessionCache); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestConfigureSSO(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	mapToUserRegistry := false
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		SSO: &openlibertyv1beta1.OpenLibertyApplicationSSO{
+			OIDC: []openlibertyv1beta1.OpenLibertyApplicationSSOOIDC{
+				{
+					ID:                "my-keycloak",
+					DiscoveryEndpoint: "https://keycloak.example.com/auth/realms/apps/.well-known/openid-configuration",
+					OpenLibertyApplicationSSOProvider: openlibertyv1beta1.OpenLibertyApplicationSSOProvider{
+						ClientID:     corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "keycloak"}, Key: "clientId"},
+						ClientSecret: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "keycloak"}, Key: "clientSecret"},
+					},
+				},
+			},
+			OpenShift:         &openlibertyv1beta1.OpenLibertyApplicationSSOOpenShift{},
+			MapToUserRegistry: &mapToUserRegistry,
+		},
+	}
+	settings := SSOSettings{
+		RedirectToRPHostAndPort:        "https://app.apps.example.com",
+		OpenShiftAuthorizationEndpoint: "https://oauth.apps.example.com/oauth/authorize",
+		OpenShiftTokenEndpoint:         "https://oauth.apps.example.com/oauth/token",
+		ServiceAccountTokenSecret:      "app-token-abcde",
+	}
+	pts := &corev1.PodTemplateSpec{}
+	cm := &corev1.ConfigMap{}
+
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	ConfigureSSO(pts, openliberty, settings)
+	CustomizeSSOConfigMap(cm, openliberty, settings)
+
+	oidcClientID, _ := findEnvVar("SSO_MY_KEYCLOAK_CLIENT_ID", pts.Spec.Containers[0].Env)
+	openshiftSecret, _ := findEnvVar("SSO_OPENSHIFT_CLIENT_SECRET", pts.Spec.Containers[0].Env)
+	_, openshiftClientIDFound := findEnvVar("SSO_OPENSHIFT_CLIENT_ID", pts.Spec.Containers[0].Env)
+	userAPIToken, _ := findEnvVar("SSO_OPENSHIFT_USER_API_TOKEN", pts.Spec.Containers[0].Env)
+	config := cm.Data["sso.xml"]
+
+	testSSO := []Test{
+		{"OIDC client ID environment variable", "keycloak", oidcClientID.ValueFrom.SecretKeyRef.Name},
+		{"OpenShift client secret from service account token", "app-token-abcde", openshiftSecret.ValueFrom.SecretKeyRef.Name},
+		{"No OpenShift client ID environment variable", false, openshiftClientIDFound},
+		{"OIDC login", true, strings.Contains(config, `<oidcLogin id="my-keycloak" clientId="${env.SSO_MY_KEYCLOAK_CLIENT_ID}"`)},
+		{"Redirect host", true, strings.Contains(config, `redirectToRPHostAndPort="https://app.apps.example.com"`)},
+		{"Map to user registry", true, strings.Contains(config, `mapToUserRegistry="false"`)},
+		{"OpenShift service account client", true, strings.Contains(config, `clientId="system:serviceaccount:openliberty:app"`)},
+		{"OpenShift token endpoint", true, strings.Contains(config, `tokenEndpoint="https://oauth.apps.example.com/oauth/token"`)},
+		{"OpenShift user API token from service account token", "app-token-abcde", userAPIToken.ValueFrom.SecretKeyRef.Name},
+		{"OpenShift token review", true, strings.Contains(config,
+			`userApi="https://kubernetes.default.svc/apis/authentication.k8s.io/v1/tokenreviews" userApiType="kube" userApiToken="${env.SSO_OPENSHIFT_USER_API_TOKEN}"`)},
+		{"Dropin mount", "/config/configDropins/overrides/sso.xml", pts.Spec.Containers[0].VolumeMounts[0].MountPath},
+	}
+	if err := verifyTests(testSSO); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test validation of reserved provider IDs
+	for _, id := range []string{"github", "GitHub", "OpenShift"} {
+		spec.SSO.OIDC[0].ID = id
+		if _, err := Validate(createOpenLibertyApp(name, namespace, spec)); err == nil {
+			t.Fatalf("Reserved OIDC provider ID %s was not rejected", id)
+		}
+	}
+
+	// Test validation of provider IDs sharing the same environment variables
+	spec.SSO.OIDC[0].ID = "my-keycloak"
+	spec.SSO.OIDC = append(spec.SSO.OIDC, spec.SSO.OIDC[0])
+	spec.SSO.OIDC[1].ID = "My_Keycloak"
+	if _, err := Validate(createOpenLibertyApp(name, namespace, spec)); err == nil {
+		t.Fatalf("Conflicting OIDC provider IDs were not rejected")
+	}
+
+	// Test the redirect annotation of a configured service account
+	expose := true
+	serviceAccountName := "shared"
+	spec.SSO.OIDC = nil
+	spec.Expose = &expose
+	spec.ServiceAccountName = &serviceAccountName
+	openliberty = createOpenLibertyApp(name, namespace, spec)
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Annotations: map[string]string{"keep": "true"}}}
+	added, _ := CustomizeSSOServiceAccountRedirect(sa, openliberty)
+	redirect := sa.Annotations[SSOServiceAccountRedirectAnnotation]
+	unchanged, _ := CustomizeSSOServiceAccountRedirect(sa, openliberty)
+
+	other := createOpenLibertyApp("other", namespace, spec)
+	_, conflictErr := CustomizeSSOServiceAccountRedirect(sa, other)
+	other.Spec.SSO = nil
+	otherRemoved, _ := CustomizeSSOServiceAccountRedirect(sa, other)
+
+	openliberty.Spec.SSO = nil
+	removed, _ := CustomizeSSOServiceAccountRedirect(sa, openliberty)
+	_, redirectFound := sa.Annotations[SSOServiceAccountRedirectAnnotation]
+
+	testRedirect := []Test{
+		{"Redirect annotation added", true, added},
+		{"Redirect to the Route of the application", `{"apiVersion":"v1","kind":"OAuthRedirectReference","reference":{"kind":"Route","name":"app"}}`, redirect},
+		{"Redirect annotation unchanged", false, unchanged},
+		{"Redirect to another application rejected", true, conflictErr != nil},
+		{"Redirect of another application kept", false, otherRemoved},
+		{"Redirect annotation removed", true, removed},
+		{"Redirect annotation not found", false, redirectFound},
+		{"Other annotations kept", "true", sa.Annotations["keep"]},
+	}
+	if err := verifyTests(testRedirect); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestConfigureLTPA(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		LTPA: &openlibertyv1beta1.OpenLibertyApplicationLTPA{RotationInterval: "24h"},
+	}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Generate the first keys
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: GetLTPASecretName(openliberty)}}
+	CustomizeLTPASecret(secret, openliberty, now)
+	keygenRequired := IsLTPAKeygenRequired(secret)
+	password := string(secret.Data["nextPassword"])
+	RotateLTPAKeys(secret, []byte("keys-1"), now)
+	firstPassword := string(secret.Data["password"])
+	firstDropin := string(secret.Data["ltpa.xml"])
+
+	// Rotate the keys once the interval has elapsed
+	CustomizeLTPASecret(secret, openliberty, now.Add(time.Hour))
+	notDue := IsLTPAKeygenRequired(secret)
+	CustomizeLTPASecret(secret, openliberty, now.Add(25*time.Hour))
+	due := IsLTPAKeygenRequired(secret)
+	RotateLTPAKeys(secret, []byte("keys-2"), now.Add(25*time.Hour))
+
+	pts := &corev1.PodTemplateSpec{}
+	autils.CustomizePodSpec(pts, openliberty)
+	ConfigureLTPA(pts, openliberty, secret)
+
+	// The keys are read from the running keygen container rather than from its status
+	job := &batchv1.Job{}
+	CustomizeLTPAJob(job, openliberty)
+	keygen := &corev1.Pod{Status: corev1.PodStatus{
+		Phase:             corev1.PodRunning,
+		ContainerStatuses: []corev1.ContainerStatus{{Name: "ltpa-keygen", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+	}}
+	keygenRunning := IsLTPAKeygenPodRunning(keygen)
+	keygen.Status.Phase = corev1.PodSucceeded
+	keygenCompleted := IsLTPAKeygenPodRunning(keygen)
+	keysContainer, keysCommand := GetLTPAKeysCommand()
+
+	testLTPA := []Test{
+		{"Secret name", "app-app-ltpa", secret.Name},
+		{"Keys not in termination message", false, strings.Contains(job.Spec.Template.Spec.Containers[0].Args[0], "termination-log")},
+		{"Keygen waits for the keys to be read", true, strings.HasSuffix(job.Spec.Template.Spec.Containers[0].Args[0], "&& sleep 600")},
+		{"Keygen pod running", true, keygenRunning},
+		{"Keygen pod completed", false, keygenCompleted},
+		{"Keys command", []string{"/bin/sh", "-c", "cat /tmp/ltpa.keys >&2"}, keysCommand},
+		{"Keys container", "ltpa-keygen", keysContainer},
+		{"Keygen required without keys", true, keygenRequired},
+		{"Keys password", password, firstPassword},
+		{"First keys password", true, strings.Contains(firstDropin, `keysPassword="`+password+`"`)},
+		{"No validation keys for first keys", false, strings.Contains(firstDropin, "validationKeys")},
+		{"Keygen not required before rotation", false, notDue},
+		{"Keygen required after rotation interval", true, due},
+		{"Current keys", "keys-2", string(secret.Data["ltpa.keys"])},
+		{"Previous keys", "keys-1", string(secret.Data["previous.ltpa.keys"])},
+		{"Validation keys", true, strings.Contains(string(secret.Data["ltpa.xml"]), `<validationKeys fileName="/config/managedLTPA/previous.ltpa.keys" password="`+password+`"/>`)},
+		{"Next rotation", 24 * time.Hour, GetLTPARotationDelay(secret, openliberty, now.Add(25*time.Hour))},
+		{"Keys mount", "/config/managedLTPA", pts.Spec.Containers[0].VolumeMounts[0].MountPath},
+		{"Dropin mount", "/config/configDropins/overrides/ltpa.xml", pts.Spec.Containers[0].VolumeMounts[1].MountPath},
+		{"Mounted keys", 3, len(pts.Spec.Volumes[0].Secret.Items)},
+	}
+	if err := verifyTests(testLTPA); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test namespace scope
+	spec.LTPA.Scope = openlibertyv1beta1.OpenLibertyApplicationLTPAScopeNamespace
+	if GetLTPASecretName(createOpenLibertyApp(name, namespace, spec)) != "openliberty-namespace-ltpa" {
+		t.Fatalf("Namespace scoped keys do not use the shared Secret")
+	}
+	// No application name maps to the shared Secret
+	if GetLTPASecretName(createOpenLibertyApp("openliberty-namespace", namespace, openlibertyv1beta1.OpenLibertyApplicationSpec{
+		LTPA: &openlibertyv1beta1.OpenLibertyApplicationLTPA{},
+	})) == "openliberty-namespace-ltpa" {
+		t.Fatalf("Application scoped keys use the shared Secret")
+	}
+}
+
+func TestResolveImageDigest(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	// Registry stand-in that requires a bearer token obtained with the pull secret credentials
+	digest := "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	var registry *httptest.Server
+	registry = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" || r.URL.Query().Get("scope") != "repository:team/app:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"token":"abc"}`)
+		case r.URL.Path == "/v2/team/app/manifests/latest":
+			if r.Header.Get("Authorization") != "Bearer abc" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:team/app:pull"`, registry.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Docker-Content-Digest", digest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer registry.Close()
+	host := strings.TrimPrefix(registry.URL, "https://")
+
+	pullSecret := &corev1.Secret{
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{"%s":{"username":"user","password":"secret"}}}`, host)),
+		},
+	}
+	resolver := &ImageDigestResolver{Client: registry.Client()}
+	resolved, err := resolver.Resolve(host+"/team/app:latest", pullSecret)
+	if err != nil {
+		t.Fatalf("Failed to resolve image digest: %v", err)
+	}
+	_, missingErr := resolver.Resolve(host+"/team/app:missing", pullSecret)
+
+	registryHost, repository, reference := ParseImageReference("my-image")
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastResolved := metav1.NewTime(now)
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: host + "/team/app:latest",
+		ImageDigest:      &openlibertyv1beta1.OpenLibertyApplicationImageDigest{PollInterval: "10m"},
+	}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	openliberty.Status.ImageReference = spec.ApplicationImage
+	openliberty.Status.ResolvedImage = GetImageWithDigest(spec.ApplicationImage, resolved)
+	openliberty.Status.ImageLastResolved = &lastResolved
+	notDue, delay := IsImageDigestResolutionDue(openliberty, now.Add(time.Minute))
+	due, _ := IsImageDigestResolutionDue(openliberty, now.Add(time.Hour))
+
+	pts := &corev1.PodTemplateSpec{}
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeImageDigest(pts, openliberty)
+
+	testImage := []Test{
+		{"Resolved digest", digest, resolved},
+		{"Missing tag", true, missingErr != nil},
+		{"Docker Hub registry", "registry-1.docker.io", registryHost},
+		{"Docker Hub official repository", "library/my-image", repository},
+		{"Default tag", "latest", reference},
+		{"Not due before poll interval", false, notDue},
+		{"Delay before next poll", 9 * time.Minute, delay},
+		{"Due after poll interval", true, due},
+		{"Pinned image", host + "/team/app@" + digest, pts.Spec.Containers[0].Image},
+	}
+	if err := verifyTests(testImage); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestCustomizeSidecarContainers(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	logsMount := corev1.VolumeMount{Name: "logs", MountPath: "/logs"}
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		Volumes:          []corev1.Volume{{Name: "logs"}},
+		VolumeMounts:     []corev1.VolumeMount{logsMount},
+		SidecarContainers: []corev1.Container{
+			{Name: "log-shipper", Image: "fluent-bit", VolumeMounts: []corev1.VolumeMount{logsMount}},
+			{Name: "proxy", Image: "envoy"},
+		},
+	}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	pts := &corev1.PodTemplateSpec{}
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeSidecarContainers(pts, openliberty)
+	withSidecars := len(pts.Spec.Containers)
+
+	// The values defaulted by the API server are kept
+	pts.Spec.Containers[2].TerminationMessagePath = "/dev/termination-log"
+	pts.Spec.Containers[2].ImagePullPolicy = corev1.PullIfNotPresent
+	defaulted := *pts.Spec.Containers[2].DeepCopy()
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeSidecarContainers(pts, openliberty)
+	keptDefaults := reflect.DeepEqual(defaulted, pts.Spec.Containers[2])
+
+	// Removing a sidecar from the spec removes it from the pods
+	openliberty.Spec.SidecarContainers = openliberty.Spec.SidecarContainers[1:]
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeSidecarContainers(pts, openliberty)
+
+	pod := &corev1.Pod{Spec: pts.Spec}
+	otherPod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "liberty"}}}}
+
+	testSidecars := []Test{
+		{"Containers with sidecars", 3, withSidecars},
+		{"Defaulted values kept", true, keptDefaults},
+		{"Application container first", "app", pts.Spec.Containers[0].Name},
+		{"Application image", appImage, pts.Spec.Containers[0].Image},
+		{"Remaining sidecar", "proxy", pts.Spec.Containers[1].Name},
+		{"Containers after removal", 2, len(pts.Spec.Containers)},
+		{"Liberty container", "app", GetLibertyContainerName(pod)},
+		{"Liberty container of other pods", "liberty", GetLibertyContainerName(otherPod)},
+	}
+	if err := verifyTests(testSidecars); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test validation of the reserved container name
+	spec.SidecarContainers[0].Name = "app"
+	if _, err := Validate(createOpenLibertyApp(name, namespace, spec)); err == nil {
+		t.Fatalf("Reserved container name was not rejected")
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	appSecret, nsSecret, clusterSecret := "app-secret", "ns-secret", "cluster-secret"
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		ApplicationImage: appImage,
+		ResourceConstraints: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+		Probes: &openlibertyv1beta1.OpenLibertyApplicationProbes{Defaults: openlibertyv1beta1.OpenLibertyApplicationProbesDefaultsMPHealth},
+	}
+	nsDefaults := &openlibertyv1beta1.OpenLibertyDefaultsSpec{
+		PullSecret: &nsSecret,
+		ResourceConstraints: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("512Mi")},
+		},
+		Monitoring: &openlibertyv1beta1.OpenLibertyApplicationMonitoring{Labels: map[string]string{"team": "payments"}},
+	}
+	clusterDefaults := &openlibertyv1beta1.OpenLibertyDefaultsSpec{
+		PullSecret:     &clusterSecret,
+		ReadinessProbe: &corev1.Probe{PeriodSeconds: 30},
+		Monitoring:     &openlibertyv1beta1.OpenLibertyApplicationMonitoring{Labels: map[string]string{"team": "platform", "k8s-app": ""}},
+	}
+
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	applied := ApplyDefaults(openliberty, nsDefaults, clusterDefaults)
+
+	withAppSecret := createOpenLibertyApp(name, namespace, spec)
+	withAppSecret.Spec.PullSecret = &appSecret
+	appliedWithAppSecret := ApplyDefaults(withAppSecret, nsDefaults, clusterDefaults)
+
+	testDefaults := []Test{
+		{"Namespace defaults take precedence", nsSecret, *openliberty.Spec.PullSecret},
+		{"Application takes precedence", appSecret, *withAppSecret.Spec.PullSecret},
+		{"Applied pull secret", true, appliedWithAppSecret.PullSecret == nil},
+		{"Application CPU request", resource.MustParse("1"), openliberty.Spec.ResourceConstraints.Requests[corev1.ResourceCPU]},
+		{"Memory request from defaults", resource.MustParse("512Mi"), openliberty.Spec.ResourceConstraints.Requests[corev1.ResourceMemory]},
+		{"Applied requests", 1, len(applied.ResourceConstraints.Requests)},
+		{"Application probe defaults take precedence", true, openliberty.Spec.ReadinessProbe == nil},
+		{"Merged monitoring labels", map[string]string{"team": "payments", "k8s-app": ""}, openliberty.Spec.Monitoring.Labels},
+		{"No defaults", true, ApplyDefaults(createOpenLibertyApp(name, namespace, spec)) == nil},
+	}
+	if err := verifyTests(testDefaults); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestApplicationMetrics(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{ApplicationImage: appImage}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	openliberty.Status.Conditions = []openlibertyv1beta1.StatusCondition{{Type: "Reconciled", Status: corev1.ConditionTrue}}
+	replicas := int32(3)
+
+	RecordApplicationReconcile(openliberty, time.Second)
+	SetApplicationReplicas(openliberty, &replicas, 2)
+	reconciled, desired, ready := getApplicationGauge("application_reconciled"), getApplicationGauge("application_desired_replicas"), getApplicationGauge("application_ready_replicas")
+
+	SetApplicationReplicas(openliberty, nil, 0)
+	defaultReplicas := getApplicationGauge("application_desired_replicas")
+
+	DeleteApplicationMetrics(namespace, name)
+
+	testMetrics := []Test{
+		{"Reconciled gauge", 1.0, reconciled},
+		{"Desired replicas", 3.0, desired},
+		{"Ready replicas", 2.0, ready},
+		{"Default desired replicas", 1.0, defaultReplicas},
+		{"Metrics deleted", -1.0, getApplicationGauge("application_reconciled")},
+		{"Dump include label", "heap,thread", GetDumpIncludeLabel([]openlibertyv1beta1.OpenLibertyDumpInclude{"thread", "heap", "thread"})},
+		{"Dump without include", "none", GetDumpIncludeLabel(nil)},
+	}
+	if err := verifyTests(testMetrics); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestDumpMetrics(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	include := []openlibertyv1beta1.OpenLibertyDumpInclude{"heap"}
+	size := ParseArchiveSize("  3145728\n")
+	RecordDump(include, time.Second, size, nil)
+	count, sum := getDumpArchiveSize("heap")
+
+	RecordDump(include, time.Second, ParseArchiveSize(""), nil)
+	unreadCount, _ := getDumpArchiveSize("heap")
+
+	testDumpMetrics := []Test{
+		{"Archive size command", []string{"/bin/sh", "-c", "wc -c < /serviceability/ns/pod/dump.zip >&2"}, GetArchiveSizeCommand("/serviceability/ns/pod/dump.zip")},
+		{"Archive size parsed", int64(3145728), size},
+		{"Archive size observed", uint64(1), count},
+		{"Archive size sum", 3145728.0, sum},
+		{"Unreadable archive size not observed", uint64(1), unreadCount},
+	}
+	if err := verifyTests(testDumpMetrics); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestUnregisterWorkqueueMetrics(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	// The workqueue of a stopped manager
+	queue := workqueue.NewNamed("test-controller")
+	queue.Add("first")
+	queue.ShutDown()
+	stopped := getWorkqueueAdds("test-controller")
+
+	// The workqueue of the restarted manager, with the same name
+	UnregisterWorkqueueMetrics()
+	queue = workqueue.NewNamed("test-controller")
+	queue.Add("first")
+	queue.Add("second")
+	queue.ShutDown()
+
+	testWorkqueue := []Test{
+		{"Adds of the stopped workqueue", 1.0, stopped},
+		{"Adds of the restarted workqueue", 2.0, getWorkqueueAdds("test-controller")},
+	}
+	if err := verifyTests(testWorkqueue); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestSetWorkloadStatus(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{ApplicationImage: appImage}
+	replicas := int32(2)
+
+	stalled := createOpenLibertyApp(name, namespace, spec)
+	deploy := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: &replicas}}
+	deploy.Status = appsv1.DeploymentStatus{
+		Replicas: 2, UpdatedReplicas: 1, ReadyReplicas: 1,
+		Conditions: []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: "timed out"}},
+	}
+	SetDeploymentStatus(stalled, deploy)
+
+	rolledOut := createOpenLibertyApp(name, namespace, spec)
+	statefulSet := &appsv1.StatefulSet{Spec: appsv1.StatefulSetSpec{Replicas: &replicas}}
+	statefulSet.Status = appsv1.StatefulSetStatus{Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, CurrentRevision: "r2", UpdateRevision: "r2"}
+	SetStatefulSetStatus(rolledOut, statefulSet)
+	readySince := rolledOut.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetLastTransitionTime()
+	SetStatefulSetStatus(rolledOut, statefulSet)
+
+	route := &routev1.Route{Spec: routev1.RouteSpec{Host: "app.example.com", Path: "/shop", TLS: &routev1.TLSConfig{}}}
+
+	testStatus := []Test{
+		{"Not ready", corev1.ConditionFalse, stalled.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetStatus()},
+		{"Ready message", "1 of 2 replicas are ready", stalled.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetMessage()},
+		{"Stalled rollout", "ProgressDeadlineExceeded", stalled.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeProgressing).GetReason()},
+		{"Ready", corev1.ConditionTrue, rolledOut.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetStatus()},
+		{"Rollout complete", "RolloutComplete", rolledOut.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeProgressing).GetReason()},
+		{"Transition time kept", readySince, rolledOut.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeReady).GetLastTransitionTime()},
+		{"Route endpoint", "https://app.example.com/shop", GetRouteEndpoint(route).URI},
+		{"Route without host", true, GetRouteEndpoint(&routev1.Route{}) == nil},
+		{"Reference", openlibertyv1beta1.OpenLibertyApplicationResourceReference{APIVersion: "route.openshift.io/v1", Kind: "Route", Name: name},
+			GetResourceReference(&routev1.Route{ObjectMeta: metav1.ObjectMeta{Name: name}})},
+	}
+	if err := verifyTests(testStatus); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestResolveWatchNamespaces(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	os.Setenv(WatchNamespaceSelectorEnvVar, "openliberty.io/watched=true")
+	selector, err := GetWatchNamespaceSelector()
+	os.Unsetenv(WatchNamespaceSelectorEnvVar)
+	if err != nil {
+		t.Fatalf("GetWatchNamespaceSelector: (%v)", err)
+	}
+	unset, _ := GetWatchNamespaceSelector()
+
+	listed, _ := ResolveWatchNamespaces(nil, []string{"ns-b", "ns-a", "ns-b"}, nil)
+	all, _ := ResolveWatchNamespaces(nil, []string{"ns-a", ""}, selector)
+
+	SetWatchNamespaces(listed)
+	watched, _ := GetWatchNamespaces()
+	SetWatchNamespaces(nil)
+
+	testNamespaces := []Test{
+		{"Selector", "openliberty.io/watched=true", selector.String()},
+		{"Selector not set", true, unset == nil},
+		{"Sorted namespaces", []string{"ns-a", "ns-b"}, listed},
+		{"All namespaces", []string{""}, all},
+		{"Cluster wide", true, IsClusterWide(all)},
+		{"Not cluster wide", false, IsClusterWide(listed)},
+		{"Watched namespaces", listed, watched},
+	}
+	if err := verifyTests(testNamespaces); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestCustomizeShutdown(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	expose := true
+	exposed := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{Expose: &expose}}
+	exposedPod := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{}}}}
+	CustomizeShutdown(exposedPod, exposed)
+
+	gracePeriod := int64(120)
+	custom := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{
+		Shutdown: &openlibertyv1beta1.OpenLibertyApplicationShutdown{DrainDelay: "2500ms", StopTimeout: "0s", TerminationGracePeriodSeconds: &gracePeriod},
+	}}
+	customPod := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{}}}}
+	CustomizeShutdown(customPod, custom)
+
+	disabled := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{
+		Shutdown: &openlibertyv1beta1.OpenLibertyApplicationShutdown{DrainDelay: "0s", StopTimeout: "0s"},
+	}}
+	disabledPod := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{}}}}
+	CustomizeShutdown(disabledPod, disabled)
+
+	drain, stop, defaultGracePeriod := GetShutdownSettings(&openlibertyv1beta1.OpenLibertyApplication{})
+
+	testShutdown := []Test{
+		{"Default drain delay", 5 * time.Second, drain},
+		{"Default stop timeout", 30 * time.Second, stop},
+		{"Default grace period", int64(40), defaultGracePeriod},
+		{"Exposed preStop", []string{"/bin/sh", "-c", "sleep 10 && server stop"}, exposedPod.Spec.Containers[0].Lifecycle.PreStop.Exec.Command},
+		{"Exposed grace period", int64(45), *exposedPod.Spec.TerminationGracePeriodSeconds},
+		{"Drain only", []string{"/bin/sh", "-c", "sleep 3"}, customPod.Spec.Containers[0].Lifecycle.PreStop.Exec.Command},
+		{"Custom grace period", gracePeriod, *customPod.Spec.TerminationGracePeriodSeconds},
+		{"Disabled", true, disabledPod.Spec.Containers[0].Lifecycle == nil},
+		{"Disabled grace period", int64(5), *disabledPod.Spec.TerminationGracePeriodSeconds},
+	}
+	if err := verifyTests(testShutdown); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	// Test validation of the shutdown settings of Knative services
+	knative := true
+	custom.Spec.ApplicationImage, custom.Spec.CreateKnativeService = appImage, &knative
+	if _, err := Validate(custom); err == nil {
+		t.Fatalf("Shutdown settings of a Knative service were not rejected")
+	}
+}
+
+func TestCustomizeDebugPod(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	target := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-1", Labels: map[string]string{
+			"app.kubernetes.io/instance": "app", "app.kubernetes.io/name": "app", "pod-template-hash": "123"}},
+		Spec: corev1.PodSpec{
+			NodeName: "node",
+			Containers: []corev1.Container{{
+				Name:          LibertyContainerName,
+				Env:           []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx512m"}},
+				LivenessProbe: &corev1.Probe{},
+			}, {Name: "sidecar"}},
+		},
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app-debug"}}
+	CustomizeDebugPod(pod, target, 5005, true)
+
+	testDebug := []Test{
+		{"Labels", map[string]string{"app.kubernetes.io/name": "app", DebugLabel: "app-debug"}, pod.Labels},
+		{"Node name", "", pod.Spec.NodeName},
+		{"Liveness probe", true, pod.Spec.Containers[0].LivenessProbe == nil},
+		{"Debug agent", []corev1.EnvVar{{Name: "JAVA_TOOL_OPTIONS", Value: "-Xmx512m -agentlib:jdwp=transport=dt_socket,server=y,suspend=y,address=127.0.0.1:5005"}}, pod.Spec.Containers[0].Env},
+		{"Debug port", []corev1.ContainerPort{{Name: "debug", ContainerPort: 5005, Protocol: corev1.ProtocolTCP}}, pod.Spec.Containers[0].Ports},
+		{"Sidecar", corev1.Container{Name: "sidecar"}, pod.Spec.Containers[1]},
+		{"Target unchanged", 1, len(target.Spec.Containers[0].Env)},
+	}
+	if err := verifyTests(testDebug); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestCustomizeBatchJob(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	port := int32(9444)
+	spec := &openlibertyv1beta1.OpenLibertyBatchJobSpec{
+		ApplicationImage:  "batch:1.0",
+		ApplicationName:   "batch",
+		JobXMLName:        "report",
+		JobParameters:     map[string]string{"quarter": "Q1", "title": "it's done"},
+		CredentialsSecret: "batch-credentials",
+		Port:              &port,
+		Serviceability:    &openlibertyv1beta1.OpenLibertyBatchJobServiceability{VolumeClaimName: "logs"},
+	}
+	jobSpec := &batchv1.JobSpec{}
+	CustomizeBatchJob(jobSpec, spec, GetBatchJobLabels("report"), "batch-ns")
+	container := jobSpec.Template.Spec.Containers[0]
+
+	script := `server start || exit 1
+batchManager submit --batchManager=localhost:9444 --user="$BATCH_USER" --password="$BATCH_PASSWORD" --trustSslCertificates --applicationName='batch' --jobXMLName='report' --jobParameter='quarter=Q1' --jobParameter='title=it'\''s done' --wait --pollingInterval_s=5 --getJobLog > /tmp/batch.log 2>&1
+rc=$?
+cat /tmp/batch.log
+grep -E 'JobInstance:|JobExecution:|[Ss]tep.*batch status' /tmp/batch.log | tail -c 4000 > /dev/termination-log
+mkdir -p /serviceability/batch-ns/$(hostname) && cp /tmp/batch.log /serviceability/batch-ns/$(hostname)/batch.log
+server stop
+[ $rc -eq 35 ] && rc=0
+exit $rc`
+
+	testBatchJob := []Test{
+		{"Backoff limit", int32(0), *jobSpec.BackoffLimit},
+		{"Restart policy", corev1.RestartPolicyNever, jobSpec.Template.Spec.RestartPolicy},
+		{"Script", script, container.Args[0]},
+		{"Credentials", "batch-credentials", container.Env[1].ValueFrom.SecretKeyRef.Name},
+		{"Serviceability mount", corev1.VolumeMount{Name: "serviceability", MountPath: "/serviceability"}, container.VolumeMounts[0]},
+		{"Serviceability claim", "logs", jobSpec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName},
+		{"Log file", "/serviceability/batch-ns/report-x1y2z/batch.log", GetBatchJobLogFile("batch-ns", "report-x1y2z")},
+	}
+	if err := verifyTests(testBatchJob); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestParseBatchJobOutput(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	out := `CWWKY0106I: JobInstance:{"jobName":"report","instanceId":12,"appName":"batch"}
+[10/19/26 2:00:03:120 UTC] Step step1 in job instance 12 and execution 14 has finished with batch status COMPLETED and exit status COMPLETED.
+[10/19/26 2:00:05:410 UTC] Step step2 in job instance 12 and execution 14 has finished with batch status FAILED and exit status RC-8.
+CWWKY0107I: JobExecution:{"jobName":"report","executionId":14,"instanceId":12,"batchStatus":"FAILED","exitStatus":"RC-8"}`
+
+	testParse := []Test{
+		{"Execution", &openlibertyv1beta1.OpenLibertyBatchJobExecution{
+			InstanceID: 12, ExecutionID: 14, BatchStatus: "FAILED", ExitStatus: "RC-8",
+			Steps: []openlibertyv1beta1.OpenLibertyBatchJobStepExecution{
+				{Name: "step1", BatchStatus: "COMPLETED", ExitStatus: "COMPLETED"},
+				{Name: "step2", BatchStatus: "FAILED", ExitStatus: "RC-8"},
+			},
+		}, ParseBatchJobOutput(out)},
+		{"No execution", true, ParseBatchJobOutput("CWWKY0104E: The job could not be submitted") == nil},
+	}
+	if err := verifyTests(testParse); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestCustomizeArtifacts(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	la := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{
+		Artifacts: []openlibertyv1beta1.OpenLibertyApplicationArtifact{{
+			Name: "orders.war",
+			Maven: &openlibertyv1beta1.OpenLibertyApplicationMavenArtifact{
+				GroupID: "com.example.shop", ArtifactID: "orders", Version: "1.2.0", Classifier: "lite",
+			},
+		}, {
+			Name: "billing.ear", URL: "https://example.com/billing.ear", Directory: "apps", CredentialsSecret: "repo",
+		}},
+	}}
+	pts := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "user"}, {Name: "artifacts"}},
+		Containers:     []corev1.Container{{}},
+	}}
+	CustomizeArtifacts(pts, la)
+
+	noArtifacts := &corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "artifacts"}},
+		Containers:     []corev1.Container{{}},
+	}}
+	CustomizeArtifacts(noArtifacts, &openlibertyv1beta1.OpenLibertyApplication{})
+
+	testArtifacts := []Test{
+		{"Maven URL", "https://repo1.maven.org/maven2/com/example/shop/orders/1.2.0/orders-1.2.0-lite.war", GetArtifactURL(&la.Spec.Artifacts[0])},
+		{"Init containers", 2, len(pts.Spec.InitContainers)},
+		{"User init container", "user", pts.Spec.InitContainers[0].Name},
+		{"Credentials", "repo", pts.Spec.InitContainers[1].Env[2].ValueFrom.SecretKeyRef.Name},
+		{"Dropins mount", corev1.VolumeMount{Name: "artifacts", MountPath: "/config/dropins/orders.war", SubPath: "dropins/orders.war", ReadOnly: true}, pts.Spec.Containers[0].VolumeMounts[0]},
+		{"Apps mount", corev1.VolumeMount{Name: "artifacts", MountPath: "/config/apps/billing.ear", SubPath: "apps/billing.ear", ReadOnly: true}, pts.Spec.Containers[0].VolumeMounts[1]},
+		{"Shared volume", corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}, pts.Spec.Volumes[0].VolumeSource},
+		{"Removed init container", true, noArtifacts.Spec.InitContainers == nil},
+	}
+	if err := verifyTests(testArtifacts); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestArtifactsScript(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+	if _, err := exec.LookPath("curl"); err != nil {
+		t.Skip("curl is not available")
+	}
+
+	war := []byte("orders application")
+	ear := []byte("billing application")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/maven2/com/example/orders/1.0/orders-1.0.war":
+			w.Write(war)
+		case "/maven2/com/example/orders/1.0/orders-1.0.war.sha1":
+			fmt.Fprintf(w, "%x", sha1.Sum(war))
+		case "/billing.ear":
+			if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write(ear)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	run := func(artifacts []openlibertyv1beta1.OpenLibertyApplicationArtifact) (string, error) {
+		dir, err := ioutil.TempDir("", "artifacts")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		cmd := exec.Command("/bin/sh", "-c", GetArtifactsScript(artifacts))
+		cmd.Env = append(os.Environ(), "ARTIFACTS_DIR="+dir, "ARTIFACT_1_USERNAME=admin", "ARTIFACT_1_PASSWORD=secret")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return "", fmt.Errorf("%v: %s", err, out)
+		}
+		data, err := ioutil.ReadFile(dir + "/apps/billing.ear")
+		return string(data), err
+	}
+
+	artifacts := []openlibertyv1beta1.OpenLibertyApplicationArtifact{{
+		Name:  "orders.war",
+		Maven: &openlibertyv1beta1.OpenLibertyApplicationMavenArtifact{Repository: server.URL + "/maven2/", GroupID: "com.example", ArtifactID: "orders", Version: "1.0"},
+	}, {
+		Name: "billing.ear", URL: server.URL + "/billing.ear", Directory: "apps", CredentialsSecret: "repo",
+		SHA256: fmt.Sprintf("%X", sha256.Sum256(ear)),
+	}}
+	downloaded, err := run(artifacts)
+
+	badChecksum := append([]openlibertyv1beta1.OpenLibertyApplicationArtifact{}, artifacts...)
+	badChecksum[1].SHA256 = fmt.Sprintf("%x", sha256.Sum256(war))
+	_, errChecksum := run(badChecksum)
+
+	missing := []openlibertyv1beta1.OpenLibertyApplicationArtifact{{Name: "missing.war", URL: server.URL + "/missing.war"}}
+	_, errMissing := run(missing)
+
+	testScript := []Test{
+		{"Download error", nil, err},
+		{"Downloaded artifact", string(ear), downloaded},
+		{"Checksum mismatch", true, errChecksum != nil},
+		{"Missing artifact", true, errMissing != nil},
+	}
+	if err := verifyTests(testScript); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestServerPause(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	enabled := true
+	la := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{PauseReadinessGate: &enabled}}
+	pts := &corev1.PodTemplateSpec{}
+	CustomizePauseReadinessGate(pts, la)
+	pod := &corev1.Pod{Spec: pts.Spec}
+	probed := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{
+		Name:          "app",
+		LivenessProbe: &corev1.Probe{Handler: corev1.Handler{HTTPGet: &corev1.HTTPGetAction{Path: "/health/live"}}},
+	}}}}
+
+	testPause := []Test{
+		{"Readiness gate", []corev1.PodReadinessGate{{ConditionType: ServingConditionType}}, pts.Spec.ReadinessGates},
+		{"Has readiness gate", true, HasServingReadinessGate(pod)},
+		{"No condition", true, GetServingCondition(pod) == nil},
+		{"Condition added", true, SetServingCondition(pod, corev1.ConditionFalse, "Paused", "")},
+		{"Condition unchanged", false, SetServingCondition(pod, corev1.ConditionFalse, "Paused", "")},
+		{"Condition updated", true, SetServingCondition(pod, corev1.ConditionTrue, "Resumed", "")},
+		{"Condition status", corev1.ConditionTrue, GetServingCondition(pod).Status},
+		{"Conditions", 1, len(pod.Status.Conditions)},
+		{"Pause command", "server pause --target=defaultHttpEndpoint,jms", GetServerPauseCommand("pause", []string{"defaultHttpEndpoint", "jms"}, probed)},
+		{"Pause all without liveness probe", "server pause", GetServerPauseCommand("pause", nil, pod)},
+		{"Pause all but probe endpoint", "server pause && server resume --target=defaultHttpEndpoint", GetServerPauseCommand("pause", nil, probed)},
+		{"Resume command", "server resume", GetServerPauseCommand("resume", nil, probed)},
+	}
+	if err := verifyTests(testPause); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	la.Spec.PauseReadinessGate = nil
+	CustomizePauseReadinessGate(pts, la)
+	if pts.Spec.ReadinessGates != nil {
+		t.Fatalf("Readiness gate wasn't removed: %v", pts.Spec.ReadinessGates)
+	}
+}
+
+func TestCustomizeQuarantinedPod(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	isController := true
+	rs := metav1.OwnerReference{Kind: "ReplicaSet", Name: "app-123", UID: "1", Controller: &isController}
+	owner := metav1.OwnerReference{Kind: "OpenLibertyQuarantine", Name: "q", UID: "2", Controller: &isController}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:            "app-123-abc",
+		Labels:          map[string]string{"app.kubernetes.io/instance": "app", "app.kubernetes.io/name": "app", "pod-template-hash": "123"},
+		OwnerReferences: []metav1.OwnerReference{rs},
+	}}
+	err := CustomizeQuarantinedPod(pod, "q", owner)
+
+	testQuarantine := []Test{
+		{"Error", nil, err},
+		{"Labels", map[string]string{"app.kubernetes.io/name": "app", QuarantineLabel: "q"}, pod.Labels},
+		{"Owner references", []metav1.OwnerReference{owner}, pod.OwnerReferences},
+		{"Quarantined again", nil, CustomizeQuarantinedPod(pod, "q", owner)},
+	}
+	if err := verifyTests(testQuarantine); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	sts := metav1.OwnerReference{Kind: "StatefulSet", Name: "app", UID: "3", Controller: &isController}
+	pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app-0", OwnerReferences: []metav1.OwnerReference{sts}}}
+	if err := CustomizeQuarantinedPod(pod, "q", owner); err == nil {
+		t.Fatalf("A pod of a StatefulSet was quarantined")
+	}
+}
+
+func TestLogWatcher(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	enabled := true
+	la := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{
+		LogWatcher: &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled, MessageIDs: []string{"CWWKE0701E"}},
+	}}
+	line := `{"type":"liberty_message","host":"app-1","ibm_messageId":"CWWKZ0002E","message":"CWWKZ0002E: An exception occurred while starting the application app.","loglevel":"ERROR"}`
+	m, ok := ParseLibertyMessage(line)
+	_, accessLog := ParseLibertyMessage(`{"type":"liberty_accesslog","ibm_requestMethod":"GET"}`)
+	_, text := ParseLibertyMessage("Launching defaultServer (Open Liberty 20.0.0.3)")
+
+	ids := GetLogWatcherMessageIDs(la)
+	testParse := []Test{
+		{"Message parsed", true, ok},
+		{"Message ID", "CWWKZ0002E", m.MessageID},
+		{"Access log skipped", false, accessLog},
+		{"Text skipped", false, text},
+		{"Default message ID", true, ids["TRAS0112W"]},
+		{"Additional message ID", true, ids["CWWKE0701E"]},
+	}
+	if err := verifyTests(testParse); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	SetApplicationErrorsStatus(la, []openlibertyv1beta1.OpenLibertyApplicationPodErrors{
+		{PodName: "app-2", Messages: []openlibertyv1beta1.OpenLibertyApplicationErrorMessage{{MessageID: "TRAS0112W", Count: 3}, {MessageID: "CWWKZ0002E", Count: 1}}},
+		{PodName: "app-1", Messages: []openlibertyv1beta1.OpenLibertyApplicationErrorMessage{{MessageID: "CWWKF0001E", Count: 2}}},
+	}, nil)
+	c := la.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeApplicationErrors)
+	testStatus := []Test{
+		{"Condition status", corev1.ConditionTrue, c.GetStatus()},
+		{"Condition message", "app-1: CWWKF0001E (2); app-2: CWWKZ0002E (1), TRAS0112W (3)", c.GetMessage()},
+		{"Pod errors", "app-1", la.Status.ApplicationErrors[0].PodName},
+	}
+	if err := verifyTests(testStatus); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	SetApplicationErrorsStatus(la, nil, []string{"app-1: pods \"app-1\" is forbidden"})
+	c = la.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeApplicationErrors)
+	testFailures := []Test{
+		{"Condition status", corev1.ConditionUnknown, c.GetStatus()},
+		{"Condition reason", "LogsUnavailable", c.GetReason()},
+		{"Condition message", "Failed to follow the logs of pods app-1: pods \"app-1\" is forbidden", c.GetMessage()},
+	}
+	if err := verifyTests(testFailures); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	SetApplicationErrorsStatus(la, nil, nil)
+	c = la.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeApplicationErrors)
+	testNoErrors := []Test{
+		{"Condition status", corev1.ConditionFalse, c.GetStatus()},
+		{"Condition reason", "NoErrors", c.GetReason()},
+		{"Pod errors", 0, len(la.Status.ApplicationErrors)},
+	}
+	if err := verifyTests(testNoErrors); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	la.Spec.LogWatcher = nil
+	SetApplicationErrorsStatus(la, nil, nil)
+	if la.Status.GetCondition(openlibertyv1beta1.StatusConditionTypeApplicationErrors) != nil {
+		t.Fatalf("ApplicationErrors condition wasn't removed")
+	}
+}
+
+func TestFFDCIncidents(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	line := `{"type":"liberty_ffdc","host":"app-1","ibm_datetime":"2020-03-02T10:15:30.123+0000","ibm_className":"com.ibm.ws.app.manager.AppManager","ibm_exceptionName":"java.lang.NullPointerException","ibm_probeID":"123"}`
+	ffdc, ok := ParseLibertyFFDC(line)
+	_, message := ParseLibertyFFDC(`{"type":"liberty_message","ibm_messageId":"CWWKZ0002E"}`)
+	dir, cmd := GetFFDCCopyCommand("ns", "app-1")
+
+	testParse := []Test{
+		{"FFDC parsed", true, ok},
+		{"Exception name", "java.lang.NullPointerException", ffdc.ExceptionName},
+		{"Probe ID", "123", ffdc.ProbeID},
+		{"Time truncated", time.Date(2020, 3, 2, 10, 15, 30, 0, time.UTC).Unix(), ffdc.GetTime().Unix()},
+		{"Message skipped", false, message},
+		{"Files directory", "/serviceability/ns/app-1/ffdc", dir},
+		{"Copy command", `mkdir -p /serviceability/ns/app-1/ffdc && cp -p "${LOG_DIR:-/logs}"/ffdc/* /serviceability/ns/app-1/ffdc/`, cmd[2]},
+	}
+	if err := verifyTests(testParse); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	first, last := metav1.NewTime(time.Unix(1000, 0)), metav1.NewTime(time.Unix(2000, 0))
+	incidents := &openlibertyv1beta1.OpenLibertyIncidents{}
+	SetIncidentsStatus(incidents, []openlibertyv1beta1.OpenLibertyIncidentsPod{
+		{PodName: "app-2", Incidents: []openlibertyv1beta1.OpenLibertyIncident{{ExceptionName: "a", ProbeID: "1", Count: 2, FirstSeen: first, LastSeen: first}}},
+		{PodName: "app-1", Incidents: []openlibertyv1beta1.OpenLibertyIncident{
+			{ExceptionName: "a", ProbeID: "1", Count: 1, FirstSeen: first, LastSeen: first},
+			{ExceptionName: "b", ProbeID: "2", Count: 3, FirstSeen: first, LastSeen: last},
+		}},
+	})
+	testStatus := []Test{
+		{"Pods sorted", "app-1", incidents.Status.Pods[0].PodName},
+		{"Incidents sorted", "b", incidents.Status.Pods[0].Incidents[0].ExceptionName},
+		{"Total count", int32(6), incidents.Status.TotalCount},
+		{"Last seen", last.Unix(), incidents.Status.LastSeen.Unix()},
+	}
+	if err := verifyTests(testStatus); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	SetIncidentsStatus(incidents, nil)
+	if incidents.Status.TotalCount != 0 || incidents.Status.LastSeen != nil || incidents.Status.Pods != nil {
+		t.Fatalf("Incidents status wasn't cleared")
+	}
+}
+
+func TestInventory(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	enabled := true
+	la := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{
+		LogWatcher: &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled, Inventory: &enabled},
+	}}
+	inv := &openlibertyv1beta1.OpenLibertyApplicationPodInventory{PodName: "app-1"}
+	messages := []LibertyMessage{
+		{MessageID: "CWWKF0012I", Message: "CWWKF0012I: The server installed the following features: [servlet-4.0, jaxrs-2.1, jndi-1.0]."},
+		{MessageID: "CWWKT0016I", Message: "CWWKT0016I: Web application available (default_host): http://app-1:9080/my-app/"},
+		{MessageID: "CWWKT0016I", Message: "CWWKT0016I: Web application available (default_host): http://app-1:9080/"},
+		{MessageID: "CWWKZ0001I", Message: "CWWKZ0001I: Application my-app started in 1.234 seconds."},
+		{MessageID: "CWWKF0011I", Message: "CWWKF0011I: The defaultServer server is ready to run a smarter planet. The defaultServer server started in 5.1234 seconds."},
+		{MessageID: "CWWKF0013I", Message: "CWWKF0013I: The server removed the following features: [jndi-1.0]."},
+	}
+	for i := range messages {
+		if !UpdatePodInventory(inv, &messages[i]) {
+			t.Fatalf("Inventory wasn't updated by %s", messages[i].MessageID)
+		}
+	}
+	other := inv.DeepCopy()
+	other.PodName = "app-2"
+	stopped := &LibertyMessage{MessageID: "CWWKZ0009I", Message: "CWWKZ0009I: The application my-app has stopped successfully."}
+
+	testUpdate := []Test{
+		{"Features", []string{"jaxrs-2.1", "servlet-4.0"}, inv.Features},
+		{"Context roots", []string{"/", "/my-app"}, inv.ContextRoots},
+		{"Applications", []string{"my-app"}, inv.Applications},
+		{"Start duration", "5.123s", inv.StartDuration},
+		{"Same message", false, UpdatePodInventory(inv, &messages[1])},
+		{"Consistent", "", GetInventoryMismatch([]openlibertyv1beta1.OpenLibertyApplicationPodInventory{*inv, *other})},
+		{"Application stopped", true, UpdatePodInventory(other, stopped)},
+		{"Mismatch", "Pods app-2 started different applications, context roots or features than pod app-1",
+			GetInventoryMismatch([]openlibertyv1beta1.OpenLibertyApplicationPodInventory{*inv, *other, *inv.DeepCopy()})},
+		{"Server launched", true, UpdatePodInventory(other, &LibertyMessage{MessageID: "CWWKE0001I"})},
+		{"Inventory reset", "", other.StartDuration},
+	}
+	if err := verifyTests(testUpdate); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	SetInventoryStatus(la, []openlibertyv1beta1.OpenLibertyApplicationPodInventory{*other, *inv})
+	if la.Status.Inventory[0].PodName != "app-1" {
+		t.Fatalf("Inventory wasn't sorted by pod: %v", la.Status.Inventory)
+	}
+	la.Spec.LogWatcher.Inventory = nil
+	SetInventoryStatus(la, []openlibertyv1beta1.OpenLibertyApplicationPodInventory{*inv})
+	if la.Status.Inventory != nil {
+		t.Fatalf("Inventory wasn't removed")
+	}
+}
+
+func TestRemediation(t *testing.T) {
+	logger := logf.ZapLogger(true)
+	logf.SetLogger(logger)
+
+	enabled, threshold, maxConcurrent := true, int32(3), int32(1)
+	la := &openlibertyv1beta1.OpenLibertyApplication{Spec: openlibertyv1beta1.OpenLibertyApplicationSpec{
+		LogWatcher: &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled},
+		Remediation: &openlibertyv1beta1.OpenLibertyApplicationRemediation{
+			MaxConcurrent: &maxConcurrent,
+			MinInterval:   "30m",
+			Rules: []openlibertyv1beta1.OpenLibertyApplicationRemediationRule{
+				{Name: "hung-threads", MessageID: "TRAS0112W", Threshold: &threshold, Period: "10m"},
+				{Name: "app-failed", MessageID: "CWWKZ0002E"},
+			},
+		},
+	}}
+	now := time.Now()
+	times := []time.Time{now.Add(-20 * time.Minute), now.Add(-9 * time.Minute), now.Add(-time.Minute), now}
+	m, _ := ParseLibertyMessage(`{"type":"liberty_message","ibm_datetime":"2020-03-02T10:15:30.123+0100","ibm_messageId":"TRAS0112W","message":"TRAS0112W: Request 1 has been running"}`)
+
+	testRules := []Test{
+		{"Enabled", true, IsRemediationEnabled(la)},
+		{"Message IDs", map[string]bool{"TRAS0112W": true, "CWWKZ0002E": true}, GetRemediationMessageIDs(la)},
+		{"Retention", 10 * time.Minute, GetRemediationRetention(la)},
+		{"Default period", 5 * time.Minute, GetRemediationRulePeriod(&la.Spec.Remediation.Rules[1])},
+		{"Default threshold", int32(1), GetRemediationRuleThreshold(&la.Spec.Remediation.Rules[1])},
+		{"Default action", openlibertyv1beta1.RemediationActionDelete, GetRemediationRuleAction(&la.Spec.Remediation.Rules[0])},
+		{"Messages in period", int32(3), CountMessages(times, now, 10*time.Minute)},
+		{"Message time", time.Date(2020, 3, 2, 9, 15, 30, 123000000, time.UTC).Unix(), m.GetTime().Unix()},
+		{"No limit", "", GetRemediationLimit(la, now)},
+	}
+	if err := verifyTests(testRules); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	AddRemediation(la, openlibertyv1beta1.OpenLibertyApplicationRemediationRecord{Rule: "hung-threads", PodName: "app-1",
+		Phase: openlibertyv1beta1.RemediationPhaseDumping, StartTime: metav1.NewTime(now.Add(-time.Hour))})
+	dumping := GetRemediationLimit(la, now)
+	SetRemediationCompleted(&la.Status.Remediations[0], nil, "Deleted the pod")
+	interval := GetRemediationLimit(la, now.Add(-40*time.Minute))
+	for i := 0; i < 12; i++ {
+		AddRemediation(la, openlibertyv1beta1.OpenLibertyApplicationRemediationRecord{Rule: "app-failed", PodName: fmt.Sprintf("app-%d", i+2),
+			Phase: openlibertyv1beta1.RemediationPhaseCompleted, StartTime: metav1.NewTime(now)})
+	}
+
+	testLimits := []Test{
+		{"Concurrent limit", "1 remediations are already running", dumping},
+		{"Completed", openlibertyv1beta1.RemediationPhaseCompleted, la.Status.Remediations[0].Phase},
+		{"Interval limit", "the last remediation started less than 30m0s ago", interval},
+		{"History limit", 10, len(la.Status.Remediations)},
+		{"Oldest dropped", "app-4", la.Status.Remediations[0].PodName},
+		{"Remediation found", "app-13", FindRemediation(la, "app-13", "app-failed").PodName},
+	}
+	if err := verifyTests(testLimits); err != nil {
+		t.Fatalf("%v", err)
+	}
+}
+
+func TestLogging(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	maxFileSize := int32(20)
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{
+		Logging: &openlibertyv1beta1.OpenLibertyApplicationLogging{
+			ConsoleLogLevel:     "warning",
+			ConsoleSources:      []openlibertyv1beta1.OpenLibertyLoggingSource{"message", "trace"},
+			JSONFieldMappings:   map[string]string{"message": "msg", "accessLog:ibm_requestHost": "host"},
+			JSONAccessLogFields: "logFormat",
+			AccessLogFormat:     `%h "%r" %s`,
+			MaxFileSize:         &maxFileSize,
+		},
+	}
+	pts := &corev1.PodTemplateSpec{}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	autils.CustomizePodSpec(pts, openliberty)
+	CustomizeLibertyEnv(pts, openliberty)
+	ConfigureLogging(pts, openliberty)
+	_, err := Validate(openliberty)
+
+	targetEnv := []corev1.EnvVar{
+		{Name: "WLP_LOGGING_CONSOLE_LOGLEVEL", Value: "warning"},
+		{Name: "WLP_LOGGING_CONSOLE_SOURCE", Value: "message,trace"},
+		{Name: "WLP_LOGGING_CONSOLE_FORMAT", Value: "json"},
+		{Name: "WLP_LOGGING_JSON_FIELD_MAPPINGS", Value: "accessLog:ibm_requestHost:host,message:msg"},
+		{Name: "WLP_LOGGING_JSON_ACCESS_LOG_FIELDS", Value: "logFormat"},
+	}
+	config := `<?xml version="1.0" encoding="UTF-8"?>
+<server>
+  <logging maxFileSize="20"/>
+  <httpAccessLogging id="operatorAccessLogging" logFormat="%h &#34;%r&#34; %s"/>
+  <httpEndpoint id="defaultHttpEndpoint" accessLoggingRef="operatorAccessLogging"/>
+</server>
+`
+	testLogging := []Test{
+		{"Logging env", targetEnv, pts.Spec.Containers[0].Env},
+		{"Logging dropin", config, renderLoggingConfig(openliberty)},
+		{"Logging dropin mount", loggingDropinMountPath, pts.Spec.Containers[0].VolumeMounts[0].MountPath},
+		{"Valid logging", nil, err},
+	}
+	if err := verifyTests(testLogging); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	enabled := true
+	invalid := map[string]func(spec *openlibertyv1beta1.OpenLibertyApplicationSpec){
+		"Conflicting env": func(spec *openlibertyv1beta1.OpenLibertyApplicationSpec) {
+			spec.Env = []corev1.EnvVar{{Name: "WLP_LOGGING_CONSOLE_SOURCE", Value: "message"}}
+		},
+		"JSON fields without JSON format": func(spec *openlibertyv1beta1.OpenLibertyApplicationSpec) {
+			spec.Logging.ConsoleFormat = "dev"
+		},
+		"Log watcher without message source": func(spec *openlibertyv1beta1.OpenLibertyApplicationSpec) {
+			spec.Logging.ConsoleSources = []openlibertyv1beta1.OpenLibertyLoggingSource{"accessLog"}
+			spec.LogWatcher = &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled}
+		},
+		"Inventory with warning level": func(spec *openlibertyv1beta1.OpenLibertyApplicationSpec) {
+			spec.LogWatcher = &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled, Inventory: &enabled}
+		},
+	}
+	for test, customize := range invalid {
+		invalidSpec := *spec.DeepCopy()
+		customize(&invalidSpec)
+		if _, err := Validate(createOpenLibertyApp(name, namespace, invalidSpec)); err == nil {
+			t.Fatalf("%s was not rejected", test)
+		}
+	}
+}
+
+func TestRuntimeLogging(t *testing.T) {
+	logf.SetLogger(logf.ZapLogger(true))
+	os.Setenv("WATCH_NAMESPACE", namespace)
+
+	spec := openlibertyv1beta1.OpenLibertyApplicationSpec{Logging: &openlibertyv1beta1.OpenLibertyApplicationLogging{}}
+	openliberty := createOpenLibertyApp(name, namespace, spec)
+	noOverridesHash, noOverridesCommand := GetRuntimeLoggingHash(openliberty), GetRuntimeLoggingCommand(openliberty)
+
+	openliberty.Spec.Logging.RuntimeOverrides = &openlibertyv1beta1.OpenLibertyApplicationLoggingOverrides{
+		ConsoleLogLevel: "warning",
+		MessageSources:  []openlibertyv1beta1.OpenLibertyLoggingSource{"message", "trace"},
+		MessageFormat:   "json",
+	}
+	hash := GetRuntimeLoggingHash(openliberty)
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: LibertyContainerName}}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: LibertyContainerName, ContainerID: "containerd://1", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		}},
+	}
+	SetAppliedRuntimeLoggingHash(pod, hash)
+	applied := GetAppliedRuntimeLoggingHash(pod)
+	pod.Status.ContainerStatuses[0].ContainerID = "containerd://2"
+
+	testRuntimeLogging := []Test{
+		{"No overrides hash", "", noOverridesHash},
+		{"No overrides command", "rm -f " + runtimeLoggingDropinPath, noOverridesCommand[2]},
+		{"Overrides config", `<?xml version="1.0" encoding="UTF-8"?>
+<server>
+  <logging consoleLogLevel="warning" messageSource="message,trace" messageFormat="json"/>
+</server>
+`, renderRuntimeLoggingConfig(openliberty)},
+		{"Overrides command", true, strings.HasSuffix(GetRuntimeLoggingCommand(openliberty)[2], "' > "+runtimeLoggingDropinPath)},
+		{"Pod annotation", hash + "@containerd://1", pod.Annotations[RuntimeLoggingAnnotation]},
+		{"Applied hash", hash, applied},
+		{"Restarted container", "", GetAppliedRuntimeLoggingHash(pod)},
+	}
+	if err := verifyTests(testRuntimeLogging); err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	enabled := true
+	openliberty.Spec.LogWatcher = &openlibertyv1beta1.OpenLibertyApplicationLogWatcher{Enabled: &enabled, Inventory: &enabled}
+	if _, err := Validate(openliberty); err == nil {
+		t.Fatalf("Runtime console log level hiding the inventory messages was not rejected")
+	}
+	openliberty.Spec.Logging.RuntimeOverrides.ConsoleLogLevel = "audit"
+	if _, err := Validate(openliberty); err != nil {
+		t.Fatalf("Valid runtime overrides were rejected: %v", err)
+	}
+}
+
 // Helper Functions
 func createOpenLibertyApp(n, ns string, spec openlibertyv1beta1.OpenLibertyApplicationSpec) *openlibertyv1beta1.OpenLibertyApplication {
 	app := &openlibertyv1beta1.OpenLibertyApplication{
@@ -143,3 +1510,59 @@ func verifyTests(tests []Test) error {
 	}
 	return nil
 }
+
+// getApplicationGauge returns the value of a gauge of the test application, or -1 when it isn't set
+func getApplicationGauge(gauge string) float64 {
+	families, _ := metrics.Registry.Gather()
+	for _, f := range families {
+		if f.GetName() != metricsNamespace+"_"+gauge {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["namespace"] == namespace && labels["application"] == name {
+				return m.GetGauge().GetValue()
+			}
+		}
+	}
+	return -1
+}
+
+// getDumpArchiveSize returns the number and the sum of the archive sizes observed for an include label
+func getDumpArchiveSize(include string) (uint64, float64) {
+	families, _ := metrics.Registry.Gather()
+	for _, f := range families {
+		if f.GetName() != metricsNamespace+"_dump_archive_size_bytes" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "include" && l.GetValue() == include {
+					return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+				}
+			}
+		}
+	}
+	return 0, 0
+}
+
+// getWorkqueueAdds returns the number of items added to a workqueue, or -1 when its metric isn't registered
+func getWorkqueueAdds(queue string) float64 {
+	families, _ := metrics.Registry.Gather()
+	for _, f := range families {
+		if f.GetName() != "workqueue_adds_total" {
+			continue
+		}
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "name" && l.GetValue() == queue {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return -1
+}
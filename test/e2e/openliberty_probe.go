@@ -2,6 +2,7 @@ package e2e
 
 import (
 	goctx "context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -9,9 +10,11 @@ import (
 	"github.com/OpenLiberty/open-liberty-operator/test/util"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	framework "github.com/operator-framework/operator-sdk/pkg/test"
 	e2eutil "github.com/operator-framework/operator-sdk/pkg/test/e2eutil"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -49,6 +52,16 @@ func OpenLibertyProbeTest(t *testing.T) {
 	if err = probeTest(t, f, ctx, libertyProbe); err != nil {
 		util.FailureCleanup(t, f, namespace, err)
 	}
+
+	// run test for startup probe holding off liveness probe
+	if err = startupProbeTest(t, f, ctx); err != nil {
+		util.FailureCleanup(t, f, namespace, err)
+	}
+
+	// run test for MicroProfile Health default probes
+	if err = defaultProbesTest(t, f, ctx); err != nil {
+		util.FailureCleanup(t, f, namespace, err)
+	}
 }
 
 func probeTest(t *testing.T, f *framework.Framework, ctx *framework.TestCtx, probe corev1.Handler) error {
@@ -76,6 +89,91 @@ func probeTest(t *testing.T, f *framework.Framework, ctx *framework.TestCtx, pro
 	return nil
 }
 
+func startupProbeTest(t *testing.T, f *framework.Framework, ctx *framework.TestCtx) error {
+	namespace, err := ctx.GetNamespace()
+	if err != nil {
+		return err
+	}
+
+	app := &openlibertyv1beta1.OpenLibertyApplication{}
+	err = f.Client.Get(goctx.TODO(), types.NamespacedName{Name: "example-liberty-readiness", Namespace: namespace}, app)
+	if err != nil {
+		return err
+	}
+
+	app.Spec.StartupProbe = &corev1.Probe{
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       5,
+		FailureThreshold:    10,
+	}
+	err = f.Client.Update(goctx.TODO(), app)
+	if err != nil {
+		return err
+	}
+
+	err = wait.Poll(retryInterval, timeout, func() (bool, error) {
+		deploy := &appsv1.Deployment{}
+		err := f.Client.Get(goctx.TODO(), types.NamespacedName{Name: "example-liberty-readiness", Namespace: namespace}, deploy)
+		if err != nil {
+			return false, err
+		}
+		liveness := deploy.Spec.Template.Spec.Containers[0].LivenessProbe
+		return liveness != nil && liveness.InitialDelaySeconds == 55, nil
+	})
+	if err != nil {
+		return fmt.Errorf("liveness probe was not delayed by the startup probe: %v", err)
+	}
+
+	return e2eutil.WaitForDeployment(t, f.KubeClient, namespace, "example-liberty-readiness", 1, retryInterval, timeout)
+}
+
+func defaultProbesTest(t *testing.T, f *framework.Framework, ctx *framework.TestCtx) error {
+	namespace, err := ctx.GetNamespace()
+	if err != nil {
+		return err
+	}
+
+	exampleOpenLiberty := util.MakeBasicOpenLibertyApplication(t, f, "example-liberty-mphealth", namespace, 1)
+	exampleOpenLiberty.Spec.ReadinessProbe = nil
+	exampleOpenLiberty.Spec.LivenessProbe = nil
+	exampleOpenLiberty.Spec.Probes = &openlibertyv1beta1.OpenLibertyApplicationProbes{
+		Defaults: openlibertyv1beta1.OpenLibertyApplicationProbesDefaultsMPHealth,
+	}
+
+	err = f.Client.Create(goctx.TODO(), exampleOpenLiberty, &framework.CleanupOptions{
+		TestContext:   ctx,
+		Timeout:       time.Second * 5,
+		RetryInterval: time.Second,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = e2eutil.WaitForDeployment(t, f.KubeClient, namespace, "example-liberty-mphealth", 1, retryInterval, timeout)
+	if err != nil {
+		return err
+	}
+
+	deploy := &appsv1.Deployment{}
+	err = f.Client.Get(goctx.TODO(), types.NamespacedName{Name: "example-liberty-mphealth", Namespace: namespace}, deploy)
+	if err != nil {
+		return err
+	}
+
+	container := deploy.Spec.Template.Spec.Containers[0]
+	if container.ReadinessProbe == nil || container.ReadinessProbe.HTTPGet.Path != "/health/ready" {
+		return fmt.Errorf("expected readiness probe on /health/ready, got %v", container.ReadinessProbe)
+	}
+	if container.LivenessProbe == nil || container.LivenessProbe.HTTPGet.Path != "/health/live" {
+		return fmt.Errorf("expected liveness probe on /health/live, got %v", container.LivenessProbe)
+	}
+	if container.LivenessProbe.InitialDelaySeconds != 120 {
+		return fmt.Errorf("expected liveness probe to be delayed by the default startup probe, got %d", container.LivenessProbe.InitialDelaySeconds)
+	}
+
+	return nil
+}
+
 func editProbeTest(t *testing.T, f *framework.Framework, ctx *framework.TestCtx, app *openlibertyv1beta1.OpenLibertyApplication) error {
 	namespace, err := ctx.GetNamespace()
 	if err != nil {